@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var applyCmd = &cobra.Command{
+	Use:    "apply",
+	Hidden: false,
+	Short:  "Apply a config from a yaml file",
+	Long: `Apply a config from a yaml file.
+Unlike import, apply compares the config with the current state of Lagoon and
+only creates or updates the objects which differ. The planned changes are
+displayed before they are made. To disable any prompts, use --force.
+
+With --prune, objects attached to those in the config which are not themselves
+in the config are removed. e.g. environments, envVariables, groups, users and
+notifications of a project, members of a group, and SSH keys of a user.
+Projects, groups, users and notifications which are not in the config are
+never removed.
+
+By default this command will exit on encountering an error. You can get it to
+continue anyway with --keep-going.`,
+	PreRunE: func(_ *cobra.Command, _ []string) error {
+		return validateTokenE(viper.GetString("current"))
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		applyFile, err := cmd.Flags().GetString("file")
+		if err != nil {
+			return err
+		}
+		prune, err := cmd.Flags().GetBool("prune")
+		if err != nil {
+			return err
+		}
+		keepGoing, err := cmd.Flags().GetBool("keep-going")
+		if err != nil {
+			return err
+		}
+		openshiftID, err := cmd.Flags().GetUint("openshiftID")
+		if err != nil {
+			return err
+		}
+		current := viper.GetString("current")
+		viper.SetDefault("lagoons."+current+".version", "1.0.0")
+		lc := newLagoonClient()
+
+		file, err := os.Open(applyFile)
+		if err != nil {
+			return fmt.Errorf("couldn't open file: %w", err)
+		}
+		defer file.Close()
+		config, err := lagoon.ParseConfig(file)
+		if err != nil {
+			return err
+		}
+
+		plan, err := lagoon.PlanApply(
+			cmdContext, lc, config, prune, openshiftID)
+		if err != nil {
+			return err
+		}
+		if len(plan.Changes) == 0 {
+			output.RenderInfo("no changes required", outputOptions)
+			return nil
+		}
+		output.RenderOutput(planTable(plan), outputOptions)
+		if err = plan.Validate(); err != nil {
+			return err
+		}
+
+		if !yesNo(fmt.Sprintf(
+			`Are you sure you want to apply these %d changes to "%s" lagoon?`,
+			len(plan.Changes), current)) {
+			return nil // user cancelled
+		}
+
+		return plan.Apply(cmdContext, keepGoing)
+	},
+}
+
+// planTable converts a plan to an output table.
+func planTable(plan *lagoon.Plan) output.Table {
+	table := output.Table{
+		Header: []string{"Action", "Kind", "Name", "Fields"},
+	}
+	for _, c := range plan.Changes {
+		var fields []string
+		for _, f := range c.Fields {
+			fields = append(fields, fmt.Sprintf("%s: %s -> %s", f.Field, f.Old, f.New))
+		}
+		table.Data = append(table.Data, []string{
+			string(c.Action),
+			c.Kind,
+			c.Name,
+			strings.Join(fields, "; "),
+		})
+	}
+	return table
+}
+
+func init() {
+	applyCmd.Flags().StringP("file", "f", "",
+		"path to the file to apply")
+	applyCmd.Flags().Bool("prune", false,
+		"remove objects attached to those in the file which are not in the file")
+	applyCmd.Flags().Bool("keep-going", false,
+		"on error, just log and continue instead of aborting")
+	applyCmd.Flags().Uint("openshiftID", 0,
+		"ID of the openshift to target when creating or updating projects")
+	if err := applyCmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/amazeeio/lagoon-cli/internal/helpers"
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/graphql"
+	"github.com/amazeeio/lagoon-cli/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Inspect the authentication to Lagoon instances",
+}
+
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the token and user of each configured Lagoon instance",
+	Long: `Show the token and user of each configured Lagoon instance.
+The subject, issuer and expiry of stored tokens are decoded locally. For tokens
+which haven't expired, the authenticated user is fetched from the Lagoon API.
+Expired tokens are not refreshed, use lagoon login to get a new token.
+Tokens from static sources and credential helpers aren't stored, so only their
+auth method is shown unless --fetch is given, in which case they are fetched
+by running the configured command or reading the configured source.
+auth status doesn't change the config file or token stores, so tokens which
+are still in plaintext in the config file aren't moved into the configured
+token store.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fetch, err := cmd.Flags().GetBool("fetch")
+		if err != nil {
+			return err
+		}
+		var statuses []lagoonAuthStatus
+		for _, l := range configuredLagoons() {
+			statuses = append(statuses, authStatus(l, fetch))
+		}
+		output.RenderOutput(authStatusTable(statuses, time.Now()), outputOptions)
+		return nil
+	},
+}
+
+// lagoonAuthStatus is the authentication status of a lagoon.
+type lagoonAuthStatus struct {
+	Lagoon     string
+	Auth       string
+	TokenStore string
+	// NotFetched is set if the token isn't stored and wasn't fetched.
+	NotFetched bool
+	// Err is the error encountered while reading the token or the user.
+	Err    error
+	Claims *tokenClaims
+	User   *schema.User
+}
+
+// tokenClaims are the claims of a token shown by auth status.
+type tokenClaims struct {
+	Subject   string
+	Issuer    string
+	ExpiresAt time.Time
+}
+
+// authStatus returns the authentication status of the given lagoon. Tokens
+// which aren't stored, such as static tokens, are only fetched if fetch is
+// true.
+func authStatus(l string, fetch bool) lagoonAuthStatus {
+	status := lagoonAuthStatus{
+		Lagoon:     l,
+		Auth:       lagoonAuthMethod(l),
+		TokenStore: lagoonTokenStoreName(l),
+	}
+	if !savesToken(status.Auth) {
+		status.TokenStore = "-"
+		if !fetch {
+			status.NotFetched = true
+			return status
+		}
+		if status.Err = loginToken(l); status.Err != nil {
+			return status
+		}
+	}
+	// auth status doesn't change anything, so plaintext tokens aren't moved
+	token, err := peekLagoonToken(l)
+	if err != nil || token == "" {
+		status.Err = err
+		return status
+	}
+	claims, err := graphql.TokenClaims(token)
+	if err != nil {
+		status.Err = fmt.Errorf("couldn't decode token: %v", err)
+		return status
+	}
+	status.Claims = &tokenClaims{
+		Subject: claims.Subject,
+		Issuer:  claims.Issuer,
+	}
+	if claims.ExpiresAt != 0 {
+		status.Claims.ExpiresAt = time.Unix(claims.ExpiresAt, 0)
+	}
+	if claims.Valid() != nil {
+		return status // don't bother the API with an expired token
+	}
+	lc, err := lagoonClient(l, token)
+	if err != nil {
+		status.Err = err
+		return status
+	}
+	status.User, status.Err = lagoon.GetMeInfo(cmdContext, lc)
+	return status
+}
+
+// authStatusTable converts the authentication statuses of lagoons to an output
+// table.
+func authStatusTable(statuses []lagoonAuthStatus, now time.Time) output.Table {
+	table := output.Table{
+		Header: []string{"Lagoon", "Auth", "TokenStore", "Status", "Subject",
+			"Issuer", "Expires", "Remaining", "User"},
+	}
+	for _, s := range statuses {
+		state, expires, remaining := "no token", "-", "-"
+		var subject, issuer, user string
+		if c := s.Claims; c != nil {
+			state = "valid"
+			subject = c.Subject
+			issuer = c.Issuer
+			if !c.ExpiresAt.IsZero() {
+				expires = c.ExpiresAt.UTC().Format(time.RFC3339)
+				if c.ExpiresAt.After(now) {
+					remaining = c.ExpiresAt.Sub(now).Round(time.Second).String()
+				} else {
+					state = "expired"
+				}
+			}
+		}
+		if s.NotFetched {
+			state = "not fetched"
+		}
+		if s.Err != nil {
+			state = "error: " + s.Err.Error()
+		}
+		if s.User != nil {
+			user = s.User.Email
+		}
+		table.Data = append(table.Data, []string{
+			s.Lagoon,
+			s.Auth,
+			s.TokenStore,
+			state,
+			helpers.ReturnNonEmptyString(subject),
+			helpers.ReturnNonEmptyString(issuer),
+			expires,
+			remaining,
+			helpers.ReturnNonEmptyString(user),
+		})
+	}
+	return table
+}
+
+func init() {
+	authCmd.AddCommand(authStatusCmd)
+	authStatusCmd.Flags().Bool("fetch", false,
+		"fetch tokens from static sources and credential helpers")
+}
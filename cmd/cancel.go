@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var cancelSelector lagoon.Selector
+
+var cancelCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "Cancel a running deployment or task",
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		return validateTokenE(viper.GetString("current"))
+	},
+}
+
+// cancelTarget returns the object a cancel command was asked to cancel, for
+// prompts and errors.
+func cancelTarget(kind string) (string, error) {
+	if cmdProjectName == "" || cmdProjectEnvironment == "" {
+		return "", errors.New("Missing arguments: Project name or environment name is not defined")
+	}
+	if cancelSelector == (lagoon.Selector{}) {
+		return "", fmt.Errorf("Missing arguments: %s name, ID or remote ID is not defined", kind)
+	}
+	return fmt.Sprintf("%s '%s' of environment '%s' in project '%s'", kind,
+		cancelSelector, cmdProjectEnvironment, cmdProjectName), nil
+}
+
+var cancelDeploymentCmd = &cobra.Command{
+	Use:     "deployment",
+	Aliases: []string{"d"},
+	Short:   "Cancel a deployment",
+	Long: `Cancel a deployment of an environment, selected by its name, ID or remote ID.
+The status of the deployment after it was cancelled is shown.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target, err := cancelTarget("deployment")
+		if err != nil {
+			return err
+		}
+		if !yesNo(fmt.Sprintf("You are attempting to cancel %s, are you sure?", target)) {
+			return nil
+		}
+		deployment, err := lagoon.CancelDeployment(cmdContext, newLagoonClient(),
+			cmdProjectName, cmdProjectEnvironment, cancelSelector)
+		if err != nil {
+			return err
+		}
+		output.RenderResult(output.Result{
+			Result: "success",
+			ResultData: map[string]interface{}{
+				"deployment": deployment.Name,
+				"status":     deployment.Status,
+			},
+		}, outputOptions)
+		return nil
+	},
+}
+
+var cancelTaskCmd = &cobra.Command{
+	Use:     "task",
+	Aliases: []string{"t"},
+	Short:   "Cancel a task",
+	Long: `Cancel a task of an environment, selected by its name, ID or remote ID.
+The status of the task after it was cancelled is shown.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		target, err := cancelTarget("task")
+		if err != nil {
+			return err
+		}
+		if !yesNo(fmt.Sprintf("You are attempting to cancel %s, are you sure?", target)) {
+			return nil
+		}
+		task, err := lagoon.CancelTask(cmdContext, newLagoonClient(),
+			cmdProjectName, cmdProjectEnvironment, cancelSelector)
+		if err != nil {
+			return err
+		}
+		output.RenderResult(output.Result{
+			Result: "success",
+			ResultData: map[string]interface{}{
+				"task":   task.Name,
+				"id":     task.ID,
+				"status": task.Status,
+			},
+		}, outputOptions)
+		return nil
+	},
+}
+
+func init() {
+	cancelCmd.AddCommand(cancelDeploymentCmd)
+	cancelCmd.AddCommand(cancelTaskCmd)
+	for _, c := range []*cobra.Command{cancelDeploymentCmd, cancelTaskCmd} {
+		c.Flags().StringVarP(&cancelSelector.Name, "name", "N", "", "The name of the "+c.Name())
+		c.Flags().UintVarP(&cancelSelector.ID, "id", "I", 0, "The ID of the "+c.Name())
+		c.Flags().StringVarP(&cancelSelector.RemoteID, "remoteid", "R", "", "The remote ID of the "+c.Name())
+	}
+}
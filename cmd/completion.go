@@ -0,0 +1,438 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/lagoon/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// completionCacheTTL is how long the names fetched from the API for shell
+// completion are reused before they are fetched again.
+const completionCacheTTL = 5 * time.Minute
+
+// completionTimeout bounds the API requests made while completing, so that a
+// slow API doesn't hang the shell.
+const completionTimeout = 5 * time.Second
+
+// The kinds of values which are completed dynamically.
+const (
+	completeLagoons                 = "lagoons"
+	completeProjects                = "projects"
+	completeEnvironments            = "environments"
+	completeServices                = "services"
+	completeGroups                  = "groups"
+	completeSlackNotifications      = "slack-notifications"
+	completeRocketChatNotifications = "rocketchat-notifications"
+)
+
+// globalFlagCompletions maps the global flags to the kind of their values.
+var globalFlagCompletions = map[string]string{
+	"lagoon":      completeLagoons,
+	"project":     completeProjects,
+	"environment": completeEnvironments,
+}
+
+// flagCompletions maps flags of individual commands to the kind of their
+// values. Flags which name a new object, such as the name of a group being
+// added, are not completed.
+var flagCompletions = []struct {
+	cmd  *cobra.Command
+	flag string
+	kind string
+}{
+	{sshEnvCmd, "service", completeServices},
+	{runCustomTask, "service", completeServices},
+	{addUserToGroupCmd, "name", completeGroups},
+	{addProjectToGroupCmd, "name", completeGroups},
+	{deleteUserFromGroupCmd, "name", completeGroups},
+	{deleteProjectFromGroupCmd, "name", completeGroups},
+	{deleteGroupCmd, "name", completeGroups},
+	{listUsersCmd, "name", completeGroups},
+	{listGroupProjectsCmd, "name", completeGroups},
+	{getUserKeysCmd, "name", completeGroups},
+	{getAllUserKeysCmd, "name", completeGroups},
+	{addProjectSlackNotificationCmd, "name", completeSlackNotifications},
+	{deleteProjectSlackNotificationCmd, "name", completeSlackNotifications},
+	{updateSlackNotificationCmd, "name", completeSlackNotifications},
+	{addProjectRocketChatNotificationCmd, "name", completeRocketChatNotifications},
+	{deleteProjectRocketChatNotificationCmd, "name", completeRocketChatNotifications},
+	{updateRocketChatNotificationCmd, "name", completeRocketChatNotifications},
+}
+
+// flagCompletion returns the kind of the values of a flag of cmd, or an empty
+// string if its values aren't completed.
+func flagCompletion(cmd *cobra.Command, flag *pflag.Flag) string {
+	for _, c := range flagCompletions {
+		if c.cmd == cmd && c.flag == flag.Name {
+			return c.kind
+		}
+	}
+	if cmd.Flags().Lookup(flag.Name) == rootCmd.PersistentFlags().Lookup(flag.Name) {
+		return globalFlagCompletions[flag.Name]
+	}
+	return ""
+}
+
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish]",
+	Short: "Output shell completion code",
+	Long: `Output shell completion code for bash, zsh or fish.
+
+To load completions in the current shell
+  bash: source <(lagoon completion bash)
+  zsh:  source <(lagoon completion zsh)
+  fish: lagoon completion fish | source
+
+Projects, environments, services, groups and notifications are completed with
+names from the API of the current Lagoon, if you are logged in. The names are
+cached for a few minutes.`,
+	ValidArgs: []string{"bash", "zsh", "fish"},
+	Args:      cobra.ExactValidArgs(1),
+	// the update check would write to the completion script
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		script := map[string]string{
+			"bash": bashCompletion,
+			"zsh":  zshCompletion,
+			"fish": fishCompletion,
+		}[args[0]]
+		_, err := fmt.Fprint(cmd.OutOrStdout(), script)
+		return err
+	},
+}
+
+// completeCmd is called by the completion scripts with the words of the
+// command line up to the cursor, and prints the candidates for the last word.
+var completeCmd = &cobra.Command{
+	Use:                "__complete",
+	Hidden:             true,
+	DisableFlagParsing: true,
+	PersistentPreRun:   func(cmd *cobra.Command, args []string) {},
+	Run: func(cmd *cobra.Command, args []string) {
+		for _, candidate := range completeArgs(args, completionNames) {
+			fmt.Fprintln(cmd.OutOrStdout(), candidate)
+		}
+	},
+}
+
+// completionArgs holds the values of the global flags given on the command
+// line being completed.
+type completionArgs struct {
+	Lagoon      string
+	Project     string
+	Environment string
+}
+
+// nameLister returns the names of the given kind. Errors are not reported, as
+// there is nowhere to report them while completing.
+type nameLister func(kind string, args completionArgs) []string
+
+// completeArgs returns the candidates for the last of args, given the words
+// before it.
+func completeArgs(args []string, names nameLister) []string {
+	current := ""
+	if len(args) > 0 {
+		current = args[len(args)-1]
+		args = args[:len(args)-1]
+	}
+	cmd := rootCmd
+	var pending *pflag.Flag
+	values := map[string]string{}
+	for i := 0; i < len(args); i++ {
+		word := args[i]
+		if word == "--" {
+			break
+		}
+		if len(word) < 2 || word[0] != '-' {
+			if sub := findSubcommand(cmd, word); sub != nil {
+				cmd = sub
+			}
+			continue
+		}
+		flag, value, hasValue := lookupFlagWord(cmd, word)
+		if flag == nil || flag.NoOptDefVal != "" {
+			continue
+		}
+		if !hasValue {
+			if i+1 == len(args) {
+				pending = flag
+				break
+			}
+			i++
+			value = args[i]
+		}
+		values[flag.Name] = value
+	}
+	completion := completionArgs{
+		Lagoon:      values["lagoon"],
+		Project:     values["project"],
+		Environment: values["environment"],
+	}
+	var candidates []string
+	prefix := ""
+	switch {
+	case pending != nil:
+		candidates = names(flagCompletion(cmd, pending), completion)
+	case strings.HasPrefix(current, "--") && strings.Contains(current, "="):
+		flag, _, _ := lookupFlagWord(cmd, current)
+		if flag != nil {
+			prefix = current[:strings.Index(current, "=")+1]
+			candidates = names(flagCompletion(cmd, flag), completion)
+		}
+	case strings.HasPrefix(current, "-"):
+		cmd.InheritedFlags()
+		cmd.Flags().VisitAll(func(f *pflag.Flag) {
+			if !f.Hidden {
+				candidates = append(candidates, "--"+f.Name)
+			}
+		})
+	default:
+		for _, sub := range cmd.Commands() {
+			if sub.IsAvailableCommand() {
+				candidates = append(candidates, sub.Name())
+			}
+		}
+		candidates = append(candidates, cmd.ValidArgs...)
+	}
+	var matches []string
+	for _, candidate := range candidates {
+		candidate = prefix + candidate
+		if strings.HasPrefix(candidate, current) {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches
+}
+
+// findSubcommand returns the subcommand of cmd with the given name or alias.
+func findSubcommand(cmd *cobra.Command, name string) *cobra.Command {
+	for _, sub := range cmd.Commands() {
+		if sub.Name() == name || sub.HasAlias(name) {
+			return sub
+		}
+	}
+	return nil
+}
+
+// lookupFlagWord returns the flag of cmd named by a word of the command line,
+// such as --project, --project=name, -p or -pname, and the value given in the
+// word if there is one.
+func lookupFlagWord(cmd *cobra.Command, word string) (*pflag.Flag, string, bool) {
+	// merges the persistent flags of the parents into cmd.Flags()
+	cmd.InheritedFlags()
+	if strings.HasPrefix(word, "--") {
+		name := word[2:]
+		if i := strings.Index(name, "="); i >= 0 {
+			return cmd.Flags().Lookup(name[:i]), name[i+1:], true
+		}
+		return cmd.Flags().Lookup(name), "", false
+	}
+	flag := cmd.Flags().ShorthandLookup(word[1:2])
+	if len(word) > 2 {
+		return flag, strings.TrimPrefix(word[2:], "="), true
+	}
+	return flag, "", false
+}
+
+// completionNames returns the names of the given kind from the config file or
+// the API of the lagoon being completed.
+func completionNames(kind string, args completionArgs) []string {
+	lagoonName := args.Lagoon
+	if lagoonName == "" {
+		lagoonName = viper.GetString("current")
+	}
+	project := args.Project
+	if project == "" {
+		project = cmdProjectName
+	}
+	environment := args.Environment
+	if environment == "" {
+		environment = cmdProjectEnvironment
+	}
+	var fetch func(context.Context, *client.Client) ([]string, error)
+	key := []string{lagoonName, kind}
+	switch kind {
+	case completeLagoons:
+		return configuredLagoons()
+	case completeProjects:
+		fetch = func(ctx context.Context, lc *client.Client) ([]string, error) {
+			return lagoon.ProjectNames(ctx, lc, true, nil)
+		}
+	case completeEnvironments, completeServices:
+		if project == "" {
+			return nil
+		}
+		key = append(key, project)
+		fetch = func(ctx context.Context, lc *client.Client) ([]string, error) {
+			if kind == completeEnvironments {
+				return lagoon.EnvironmentNames(ctx, lc, project)
+			}
+			return lagoon.ServiceNames(ctx, lc, project, environment)
+		}
+		if kind == completeServices && environment != "" {
+			key = append(key, environment)
+		}
+	case completeGroups:
+		fetch = func(ctx context.Context, lc *client.Client) ([]string, error) {
+			return lagoon.GroupNames(ctx, lc)
+		}
+	case completeSlackNotifications:
+		fetch = func(ctx context.Context, lc *client.Client) ([]string, error) {
+			return lagoon.SlackNotificationNames(ctx, lc)
+		}
+	case completeRocketChatNotifications:
+		fetch = func(ctx context.Context, lc *client.Client) ([]string, error) {
+			return lagoon.RocketChatNotificationNames(ctx, lc)
+		}
+	default:
+		return nil
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return nil
+	}
+	names, _ := cachedNames(filepath.Join(cacheDir, "lagoon-cli", "completion"),
+		strings.Join(key, "-"), func() ([]string, error) {
+			if err := completionLogin(lagoonName); err != nil {
+				return nil, err
+			}
+			cmdRetries = 0
+			ctx, cancel := context.WithTimeout(cmdContext, completionTimeout)
+			defer cancel()
+			return fetch(ctx, newLagoonClientFor(lagoonName))
+		})
+	return names
+}
+
+// completionLogin checks that there is a token for the given lagoon which can
+// be used without user interaction. Completion never prompts or runs
+// credential helpers, so it only works once the user has logged in, and the
+// token store can be opened without a passphrase prompt. Tokens which aren't
+// stored are only used if they have been fetched already.
+func completionLogin(lagoon string) error {
+	if !savesToken(lagoonAuthMethod(lagoon)) {
+		if token, _ := readLagoonToken(lagoon); token == "" {
+			return errors.New("no cached token")
+		}
+		return nil
+	}
+	if lagoonTokenStoreName(lagoon) == "encrypted-file" &&
+		os.Getenv("LAGOON_TOKEN_PASSPHRASE") == "" {
+		return errors.New("the token store needs a passphrase")
+	}
+	valid, err := hasValidToken(lagoon)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return errors.New("not logged in")
+	}
+	return nil
+}
+
+// cachedNames returns the names cached in dir under key if they are younger
+// than completionCacheTTL. Otherwise they are fetched and cached. If fetching
+// fails, expired names are returned along with the error.
+func cachedNames(dir, key string,
+	fetch func() ([]string, error)) ([]string, error) {
+	path := filepath.Join(dir, url.PathEscape(key)+".json")
+	var cached []string
+	info, err := os.Stat(path)
+	if err == nil {
+		if data, err := ioutil.ReadFile(path); err == nil &&
+			json.Unmarshal(data, &cached) == nil &&
+			time.Since(info.ModTime()) < completionCacheTTL {
+			return cached, nil
+		}
+	}
+	names, err := fetch()
+	if err != nil {
+		return cached, err
+	}
+	sort.Strings(names)
+	data, err := json.Marshal(names)
+	if err != nil {
+		return names, err
+	}
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return names, err
+	}
+	return names, ioutil.WriteFile(path, data, 0600)
+}
+
+const bashCompletion = `# bash completion for lagoon
+
+_lagoon_complete() {
+    local cur words cword
+    if declare -F _get_comp_words_by_ref >/dev/null 2>&1; then
+        _get_comp_words_by_ref -n =: cur words cword
+    else
+        cur="${COMP_WORDS[COMP_CWORD]}"
+        words=("${COMP_WORDS[@]}")
+        cword=$COMP_CWORD
+    fi
+    local IFS=$'\n'
+    COMPREPLY=($("${words[0]}" __complete "${words[@]:1:$cword}" 2>/dev/null))
+    # readline only replaces the part of --flag=value after the =
+    if [[ $cur == --*=* ]]; then
+        COMPREPLY=("${COMPREPLY[@]#"${cur%%=*}="}")
+    fi
+}
+
+complete -o default -F _lagoon_complete lagoon
+`
+
+const zshCompletion = `#compdef lagoon
+
+_lagoon() {
+    local -a completions
+    completions=(${(f)"$(${words[1]} __complete "${(@)words[2,$CURRENT]}" 2>/dev/null)"})
+    if (( ${#completions} )); then
+        if [[ $PREFIX == --*=* ]]; then
+            compset -P '*='
+            completions=(${completions#*=})
+        fi
+        compadd -a completions
+    else
+        _files
+    fi
+}
+
+if [ "$funcstack[1]" = "_lagoon" ]; then
+    _lagoon "$@"
+else
+    compdef _lagoon lagoon
+fi
+`
+
+const fishCompletion = `# fish completion for lagoon
+
+function __lagoon_complete
+    set -l args (commandline -opc)
+    set -l lagoon $args[1]
+    set -e args[1]
+    $lagoon __complete $args (commandline -ct) 2>/dev/null
+end
+
+function __lagoon_has_completions
+    test (count (__lagoon_complete)) -gt 0
+end
+
+complete -c lagoon -e
+complete -c lagoon -n __lagoon_has_completions -f -a '(__lagoon_complete)'
+complete -c lagoon -n 'not __lagoon_has_completions' -F
+`
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCompleteArgs(t *testing.T) {
+	names := func(kind string, args completionArgs) []string {
+		switch kind {
+		case completeProjects:
+			return []string{"drupal", "wordpress"}
+		case completeEnvironments:
+			return []string{args.Project + "-main", args.Project + "-develop"}
+		case completeServices:
+			return []string{"cli", args.Environment + "-nginx"}
+		case completeGroups:
+			return []string{"admins", "developers"}
+		}
+		return nil
+	}
+	var testCases = map[string]struct {
+		args   []string
+		expect []string
+	}{
+		"subcommands": {
+			args:   []string{"list", "pro"},
+			expect: []string{"projects"},
+		},
+		"alias": {
+			args:   []string{"l", "deployments", "--proj"},
+			expect: []string{"--project"},
+		},
+		"valid args": {
+			args:   []string{"completion", ""},
+			expect: []string{"bash", "zsh", "fish"},
+		},
+		"project flag": {
+			args:   []string{"ssh", "-p", "d"},
+			expect: []string{"drupal"},
+		},
+		"flag with equals": {
+			args:   []string{"ssh", "--project=w"},
+			expect: []string{"--project=wordpress"},
+		},
+		"environments of project": {
+			args:   []string{"--project=drupal", "ssh", "-e", ""},
+			expect: []string{"drupal-main", "drupal-develop"},
+		},
+		"services of environment": {
+			args:   []string{"ssh", "-p", "drupal", "-emain", "--service", ""},
+			expect: []string{"cli", "main-nginx"},
+		},
+		"groups": {
+			args:   []string{"delete", "group", "-N", "a"},
+			expect: []string{"admins"},
+		},
+		"new group name": {
+			args:   []string{"add", "group", "-N", ""},
+			expect: nil,
+		},
+		"after a flag value": {
+			args:   []string{"-p", "drupal", "li"},
+			expect: []string{"list"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			result := completeArgs(tc.args, names)
+			if !reflect.DeepEqual(result, tc.expect) {
+				tt.Fatalf("expected %v, got %v", tc.expect, result)
+			}
+		})
+	}
+}
+
+func TestCachedNames(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lagoon-completion")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fetches := 0
+	fetch := func() ([]string, error) {
+		fetches++
+		return []string{"b", "a"}, nil
+	}
+	fail := func() ([]string, error) {
+		return nil, errors.New("offline")
+	}
+	key := "amazeeio-environments-drupal"
+	names, err := cachedNames(dir, key, fetch)
+	if err != nil || !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Fatalf("unexpected names %v, error %v", names, err)
+	}
+	names, err = cachedNames(dir, key, fail)
+	if err != nil || !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Fatalf("expected cached names, got %v, error %v", names, err)
+	}
+	// expire the cache
+	expired := time.Now().Add(-2 * completionCacheTTL)
+	if err = os.Chtimes(filepath.Join(dir, key+".json"), expired, expired); err != nil {
+		t.Fatal(err)
+	}
+	names, err = cachedNames(dir, key, fail)
+	if err == nil || !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Fatalf("expected expired names and an error, got %v, error %v", names, err)
+	}
+	if _, err = cachedNames(dir, key, fetch); err != nil || fetches != 2 {
+		t.Fatalf("expected names to be fetched again, got %d fetches, error %v", fetches, err)
+	}
+}
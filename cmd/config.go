@@ -2,12 +2,18 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/amazeeio/lagoon-cli/internal/lagoon/client"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/logrusorgru/aurora"
 	"github.com/spf13/cobra"
@@ -24,6 +30,24 @@ type LagoonConfigFlags struct {
 	Token    string `json:"token,omitempty"`
 	UI       string `json:"ui,omitempty"`
 	Kibana   string `json:"kibana,omitempty"`
+	// TLS settings of the GraphQL endpoint
+	CAFile             string `json:"ca-file,omitempty"`
+	ClientCert         string `json:"client-cert,omitempty"`
+	ClientKey          string `json:"client-key,omitempty"`
+	InsecureSkipVerify bool   `json:"insecure-skip-verify,omitempty"`
+	// SSH settings
+	KnownHostsFile string `json:"known-hosts-file,omitempty"`
+	SSHKey         string `json:"ssh-key,omitempty"`
+	// token acquisition settings
+	Auth             string `json:"auth,omitempty"`
+	KeycloakURL      string `json:"keycloak-url,omitempty"`
+	KeycloakClientID string `json:"keycloak-client-id,omitempty"`
+	KeycloakGrant    string `json:"keycloak-grant,omitempty"`
+	KeycloakUsername string `json:"keycloak-username,omitempty"`
+	TokenEnv         string `json:"token-env,omitempty"`
+	TokenFile        string `json:"token-file,omitempty"`
+	TokenHelper      string `json:"token-helper,omitempty"`
+	TokenStore       string `json:"token-store,omitempty"`
 }
 
 func parseLagoonConfig(flags pflag.FlagSet) LagoonConfigFlags {
@@ -88,6 +112,26 @@ var configLagoonsCmd = &cobra.Command{
 				fmt.Println(fmt.Sprintf(" - %s: %d", aurora.Yellow("Port"), viper.GetInt("lagoons."+lagoon.String()+".port")))
 				fmt.Println(fmt.Sprintf(" - %s: %s", aurora.Yellow("UI"), viper.GetString("lagoons."+lagoon.String()+".ui")))
 				fmt.Println(fmt.Sprintf(" - %s: %s", aurora.Yellow("Kibana"), viper.GetString("lagoons."+lagoon.String()+".kibana")))
+				tlsConfig := lagoonTLSConfig(lagoon.String())
+				if tlsConfig.CAFile != "" {
+					fmt.Println(fmt.Sprintf(" - %s: %s", aurora.Yellow("CA file"), tlsConfig.CAFile))
+				}
+				if tlsConfig.ClientCert != "" {
+					fmt.Println(fmt.Sprintf(" - %s: %s", aurora.Yellow("Client certificate"), tlsConfig.ClientCert))
+					fmt.Println(fmt.Sprintf(" - %s: %s", aurora.Yellow("Client key"), tlsConfig.ClientKey))
+				}
+				if tlsConfig.InsecureSkipVerify {
+					fmt.Println(fmt.Sprintf(" - %s: %s", aurora.Yellow("TLS verification"), aurora.Red("disabled")))
+				}
+				hostKeyConfig := lagoonHostKeyConfig(lagoon.String())
+				if len(hostKeyConfig.Fingerprints) > 0 {
+					fmt.Println(fmt.Sprintf(" - %s: %s", aurora.Yellow("Host key fingerprints"), strings.Join(hostKeyConfig.Fingerprints, ", ")))
+				} else {
+					fmt.Println(fmt.Sprintf(" - %s: %s", aurora.Yellow("Known hosts file"), hostKeyConfig.KnownHostsFile))
+				}
+				if sshKey := viper.GetString("lagoons." + lagoon.String() + ".ssh-key"); sshKey != "" {
+					fmt.Println(fmt.Sprintf(" - %s: %s", aurora.Yellow("SSH key"), sshKey))
+				}
 			}
 			fmt.Println("\nYour default Lagoon is:")
 			fmt.Println(fmt.Sprintf("%s: %s\n", aurora.Yellow("Name"), viper.Get("default")))
@@ -104,6 +148,28 @@ var configLagoonsCmd = &cobra.Command{
 					"ui":       viper.GetString("lagoons." + lagoon.String() + ".ui"),
 					"kibana":   viper.GetString("lagoons." + lagoon.String() + ".Kibana"),
 				}
+				tlsConfig := lagoonTLSConfig(lagoon.String())
+				if tlsConfig.CAFile != "" {
+					lagoonMapData["ca-file"] = tlsConfig.CAFile
+				}
+				if tlsConfig.ClientCert != "" {
+					lagoonMapData["client-cert"] = tlsConfig.ClientCert
+					lagoonMapData["client-key"] = tlsConfig.ClientKey
+				}
+				if tlsConfig.InsecureSkipVerify {
+					lagoonMapData["insecure-skip-verify"] = true
+				}
+				hostKeyConfig := lagoonHostKeyConfig(lagoon.String())
+				if len(hostKeyConfig.Fingerprints) > 0 {
+					lagoonMapData["host-key-fingerprints"] = hostKeyConfig.Fingerprints
+				} else {
+					lagoonMapData["known-hosts-file"] = hostKeyConfig.KnownHostsFile
+				}
+				lagoonMapData["auth"] = lagoonAuthMethod(lagoon.String())
+				if sshKey := viper.GetString("lagoons." + lagoon.String() + ".ssh-key"); sshKey != "" {
+					lagoonMapData["ssh-key"] = sshKey
+				}
+				lagoonMapData["token-store"] = lagoonTokenStoreName(lagoon.String())
 				lagoonsData = append(lagoonsData, lagoonMapData)
 			}
 			returnedData := map[string]interface{}{
@@ -138,14 +204,83 @@ var configAddCmd = &cobra.Command{
 			if lagoonConfig.Kibana != "" {
 				viper.Set("lagoons."+lagoonConfig.Lagoon+".kibana", lagoonConfig.Kibana)
 			}
-			if lagoonConfig.Token != "" {
-				viper.Set("lagoons."+lagoonConfig.Lagoon+".token", lagoonConfig.Token)
+			// store absolute paths so that the config works from any directory
+			for _, path := range []*string{&lagoonConfig.CAFile,
+				&lagoonConfig.ClientCert, &lagoonConfig.ClientKey} {
+				if *path != "" {
+					abs, err := filepath.Abs(*path)
+					handleError(err)
+					*path = abs
+				}
+			}
+			tlsConfig := client.TLSConfig{
+				CAFile:             lagoonConfig.CAFile,
+				ClientCert:         lagoonConfig.ClientCert,
+				ClientKey:          lagoonConfig.ClientKey,
+				InsecureSkipVerify: lagoonConfig.InsecureSkipVerify,
+			}
+			if err := tlsConfig.Validate(); err != nil {
+				output.RenderError(fmt.Sprintf("invalid TLS config: %v", err), outputOptions)
+				os.Exit(1)
+			}
+			if lagoonConfig.CAFile != "" {
+				viper.Set("lagoons."+lagoonConfig.Lagoon+".ca-file", lagoonConfig.CAFile)
+			}
+			if lagoonConfig.ClientCert != "" {
+				viper.Set("lagoons."+lagoonConfig.Lagoon+".client-cert", lagoonConfig.ClientCert)
+				viper.Set("lagoons."+lagoonConfig.Lagoon+".client-key", lagoonConfig.ClientKey)
+			}
+			if lagoonConfig.InsecureSkipVerify {
+				viper.Set("lagoons."+lagoonConfig.Lagoon+".insecure-skip-verify", true)
+			}
+			if lagoonConfig.KnownHostsFile != "" {
+				knownHostsFile, err := filepath.Abs(lagoonConfig.KnownHostsFile)
+				handleError(err)
+				viper.Set("lagoons."+lagoonConfig.Lagoon+".known-hosts-file", knownHostsFile)
+			}
+			if len(lagoonHostKeyFingerprints) > 0 {
+				viper.Set("lagoons."+lagoonConfig.Lagoon+".host-key-fingerprints", lagoonHostKeyFingerprints)
+			}
+			if lagoonConfig.SSHKey != "" {
+				sshKey, err := filepath.Abs(lagoonConfig.SSHKey)
+				handleError(err)
+				viper.Set("lagoons."+lagoonConfig.Lagoon+".ssh-key", sshKey)
+			}
+			if lagoonConfig.TokenFile != "" {
+				tokenFile, err := filepath.Abs(lagoonConfig.TokenFile)
+				handleError(err)
+				lagoonConfig.TokenFile = tokenFile
+			}
+			if err := validateAuthConfig(lagoonConfig); err != nil {
+				output.RenderError(fmt.Sprintf("invalid auth config: %v", err), outputOptions)
+				os.Exit(1)
+			}
+			for key, value := range map[string]string{
+				"auth":               lagoonConfig.Auth,
+				"keycloak-url":       lagoonConfig.KeycloakURL,
+				"keycloak-client-id": lagoonConfig.KeycloakClientID,
+				"keycloak-grant":     lagoonConfig.KeycloakGrant,
+				"keycloak-username":  lagoonConfig.KeycloakUsername,
+				"token-env":          lagoonConfig.TokenEnv,
+				"token-file":         lagoonConfig.TokenFile,
+				"token-helper":       lagoonConfig.TokenHelper,
+				"token-store":        lagoonConfig.TokenStore,
+			} {
+				if value != "" {
+					viper.Set("lagoons."+lagoonConfig.Lagoon+"."+key, value)
+				}
 			}
 			err := viper.WriteConfigAs(filepath.Join(configFilePath, configName+configExtension))
 			if err != nil {
 				output.RenderError(err.Error(), outputOptions)
 				os.Exit(1)
 			}
+			if lagoonConfig.Token != "" {
+				if err = setLagoonSecret(lagoonConfig.Lagoon, "token", lagoonConfig.Token); err != nil {
+					output.RenderError(fmt.Sprintf("couldn't save token: %v", err), outputOptions)
+					os.Exit(1)
+				}
+			}
 			resultData := output.Result{
 				Result: "success",
 				ResultData: map[string]interface{}{
@@ -157,6 +292,25 @@ var configAddCmd = &cobra.Command{
 					"kibana":   lagoonConfig.Kibana,
 				},
 			}
+			if lagoonConfig.CAFile != "" {
+				resultData.ResultData["ca-file"] = lagoonConfig.CAFile
+			}
+			if lagoonConfig.ClientCert != "" {
+				resultData.ResultData["client-cert"] = lagoonConfig.ClientCert
+				resultData.ResultData["client-key"] = lagoonConfig.ClientKey
+			}
+			if lagoonConfig.InsecureSkipVerify {
+				resultData.ResultData["insecure-skip-verify"] = true
+			}
+			if len(lagoonHostKeyFingerprints) > 0 {
+				resultData.ResultData["host-key-fingerprints"] = lagoonHostKeyFingerprints
+			}
+			if lagoonConfig.Auth != "" {
+				resultData.ResultData["auth"] = lagoonConfig.Auth
+			}
+			if lagoonConfig.TokenStore != "" {
+				resultData.ResultData["token-store"] = lagoonConfig.TokenStore
+			}
 			output.RenderResult(resultData, outputOptions)
 		} else {
 			output.RenderError("Must have Hostname, Port, and GraphQL endpoint", outputOptions)
@@ -164,6 +318,92 @@ var configAddCmd = &cobra.Command{
 	},
 }
 
+// validateAuthConfig checks that the settings required by the auth method of
+// a lagoon are set.
+func validateAuthConfig(c LagoonConfigFlags) error {
+	switch c.Auth {
+	case "", "ssh":
+	case "keycloak":
+		if c.KeycloakURL == "" {
+			return fmt.Errorf("keycloak auth requires --keycloak-url")
+		}
+		switch c.KeycloakGrant {
+		case "", "device":
+		case "password":
+			if c.KeycloakUsername == "" {
+				return fmt.Errorf("the keycloak password grant requires --keycloak-username")
+			}
+		default:
+			return fmt.Errorf("unknown keycloak grant %q, must be device or password", c.KeycloakGrant)
+		}
+	case "static":
+		if c.TokenEnv == "" && c.TokenFile == "" {
+			return fmt.Errorf("static auth requires --token-env or --token-file")
+		}
+	case "helper":
+		if c.TokenHelper == "" {
+			return fmt.Errorf("helper auth requires --token-helper")
+		}
+	default:
+		return fmt.Errorf("unknown auth method %q, must be ssh, keycloak, static or helper", c.Auth)
+	}
+	if c.TokenStore != "" {
+		if _, err := tokenStore(c.TokenStore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var configTokenStoreCmd = &cobra.Command{
+	Use:   "token-store [file|encrypted-file|keyring]",
+	Short: "Set where the tokens of a Lagoon are stored",
+	Long: `Set where the tokens of a Lagoon are stored, and move its existing tokens to
+the new store.
+
+file stores tokens in plaintext in the config file. encrypted-file stores them
+in ~/.lagoon-tokens, encrypted with a passphrase which is read from the
+LAGOON_TOKEN_PASSPHRASE environment variable or prompted for. keyring stores
+them in the Secret Service of your desktop session (e.g. GNOME Keyring) using
+secret-tool, from libsecret. keyring is only available on Linux; use
+encrypted-file on macOS and Windows.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		lagoonConfig := parseLagoonConfig(*cmd.Flags())
+		if lagoonConfig.Lagoon == "" {
+			return fmt.Errorf("Missing arguments: Lagoon name is not defined")
+		}
+		if !viper.IsSet("lagoons." + lagoonConfig.Lagoon) {
+			return fmt.Errorf("lagoon %s is not configured", lagoonConfig.Lagoon)
+		}
+		from, err := openLagoonTokenStore(lagoonConfig.Lagoon)
+		if err != nil {
+			return err
+		}
+		to, err := tokenStore(args[0])
+		if err != nil {
+			return err
+		}
+		if args[0] != lagoonTokenStoreName(lagoonConfig.Lagoon) {
+			if err = moveSecrets(lagoonConfig.Lagoon, from, to); err != nil {
+				return fmt.Errorf("couldn't move tokens: %w", err)
+			}
+		}
+		viper.Set("lagoons."+lagoonConfig.Lagoon+".token-store", args[0])
+		if err = viper.WriteConfig(); err != nil {
+			return err
+		}
+		output.RenderResult(output.Result{
+			Result: "success",
+			ResultData: map[string]interface{}{
+				"lagoon":      lagoonConfig.Lagoon,
+				"token-store": args[0],
+			},
+		}, outputOptions)
+		return nil
+	},
+}
+
 var configDeleteCmd = &cobra.Command{
 	Use:     "delete",
 	Aliases: []string{"d"},
@@ -220,6 +460,86 @@ var configGetCurrent = &cobra.Command{
 	},
 }
 
+var configValidateCmd = &cobra.Command{
+	Use:     "validate [file]",
+	Aliases: []string{"v"},
+	Short:   "Validate a config file for import",
+	Long: `Validate a config file for import.
+Checks that names are unique, and that enum values such as envVariable scopes,
+deploy types, environment types and group roles are valid. Objects referred to
+in the file (users, groups, billing groups and notifications) which aren't
+declared in the file are reported as warnings, as they may exist in Lagoon
+already. Use --strict to treat these warnings as errors. This validation also
+runs automatically before import, where warnings are logged.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		strict, err := cmd.Flags().GetBool("strict")
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			return fmt.Errorf("couldn't read file: %w", err)
+		}
+		warnings, err := schema.ValidateConfigYAML(data, strict)
+		var errs schema.ValidationErrors
+		if err != nil && !errors.As(err, &errs) {
+			return fmt.Errorf("couldn't unmarshal config: %w", err)
+		}
+		if len(errs) == 0 && len(warnings) == 0 {
+			output.RenderInfo(fmt.Sprintf("%s is valid", args[0]), outputOptions)
+			return nil
+		}
+		table := output.Table{
+			Header: []string{"Line", "Column", "Path", "Level", "Message"},
+		}
+		problems := append(errs, warnings...)
+		sort.SliceStable(problems, func(i, j int) bool {
+			return problems[i].Position.Line < problems[j].Position.Line
+		})
+		for _, e := range problems {
+			level := "error"
+			if e.Warning && !strict {
+				level = "warning"
+			}
+			table.Data = append(table.Data, []string{
+				strconv.Itoa(e.Position.Line),
+				strconv.Itoa(e.Position.Column),
+				e.Path,
+				level,
+				e.Message,
+			})
+		}
+		output.RenderOutput(table, outputOptions)
+		if len(errs) == 0 {
+			return nil
+		}
+		// the errors have been rendered already
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+		return exitCodeError(1)
+	},
+}
+
+var configSchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON Schema for import and export config files",
+	Long: `Print the JSON Schema for import and export config files.
+Editors which support JSON Schema can use it to autocomplete and validate config
+files. For example, with the YAML language server add this comment to the top of
+a config file:
+
+# yaml-language-server: $schema=https://amazeeio.github.io/lagoon-cli/config.schema.json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		data, err := schema.JSONSchema()
+		if err != nil {
+			return fmt.Errorf("couldn't generate schema: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	},
+}
+
 var updateCheck string
 var projectDirectoryCheck string
 
@@ -230,6 +550,11 @@ func init() {
 	configCmd.AddCommand(configDeleteCmd)
 	configCmd.AddCommand(configFeatureSwitch)
 	configCmd.AddCommand(configLagoonsCmd)
+	configCmd.AddCommand(configValidateCmd)
+	configValidateCmd.Flags().Bool("strict", false,
+		"treat references to objects not declared in the file as errors")
+	configCmd.AddCommand(configSchemaCmd)
+	configCmd.AddCommand(configTokenStoreCmd)
 	configAddCmd.Flags().StringVarP(&lagoonHostname, "hostname", "H", "", "Lagoon SSH hostname")
 	configAddCmd.Flags().StringVarP(&lagoonPort, "port", "P", "", "Lagoon SSH port")
 	configAddCmd.Flags().StringVarP(&lagoonGraphQL, "graphql", "g", "", "Lagoon GraphQL endpoint")
@@ -237,6 +562,21 @@ func init() {
 	configAddCmd.Flags().StringVarP(&lagoonUI, "ui", "u", "", "Lagoon UI location (https://ui-lagoon-master.ch.amazee.io)")
 	configAddCmd.PersistentFlags().BoolVarP(&createConfig, "create-config", "", false, "Create the config file if it is non existent (to be used with --config-file)")
 	configAddCmd.Flags().StringVarP(&lagoonKibana, "kibana", "k", "", "Lagoon Kibana URL (https://logs-db-ui-lagoon-master.ch.amazee.io)")
+	configAddCmd.Flags().StringVarP(&lagoonCAFile, "ca-file", "", "", "PEM file of additional CA certificates to trust for the GraphQL endpoint")
+	configAddCmd.Flags().StringVarP(&lagoonClientCert, "client-cert", "", "", "PEM client certificate for the GraphQL endpoint (requires --client-key)")
+	configAddCmd.Flags().StringVarP(&lagoonClientKey, "client-key", "", "", "PEM client key for the GraphQL endpoint (requires --client-cert)")
+	configAddCmd.Flags().StringSliceVarP(&lagoonHostKeyFingerprints, "host-key-fingerprint", "", nil, "SHA256 fingerprint of the Lagoon SSH host key to accept (can be repeated); other host keys are rejected")
+	configAddCmd.Flags().StringVarP(&lagoonKnownHostsFile, "known-hosts-file", "", "", "known_hosts file for the Lagoon SSH host keys (default ~/.lagoon.known_hosts)")
+	configAddCmd.Flags().BoolVarP(&lagoonInsecureSkipVerify, "insecure-skip-verify", "", false, "Disable TLS certificate verification for the GraphQL endpoint (insecure)")
+	configAddCmd.Flags().StringVarP(&lagoonAuth, "auth", "", "", "How to get a GraphQL token: ssh, keycloak, static or helper (default ssh)")
+	configAddCmd.Flags().StringVarP(&lagoonKeycloakURL, "keycloak-url", "", "", "URL of the Lagoon Keycloak realm (https://keycloak.example.com/auth/realms/lagoon)")
+	configAddCmd.Flags().StringVarP(&lagoonKeycloakClientID, "keycloak-client-id", "", "", "Keycloak client ID (default lagoon-cli)")
+	configAddCmd.Flags().StringVarP(&lagoonKeycloakGrant, "keycloak-grant", "", "", "Keycloak grant: device or password (default device)")
+	configAddCmd.Flags().StringVarP(&lagoonKeycloakUsername, "keycloak-username", "", "", "Keycloak username for the password grant")
+	configAddCmd.Flags().StringVarP(&lagoonTokenEnv, "token-env", "", "", "Environment variable holding a static GraphQL token")
+	configAddCmd.Flags().StringVarP(&lagoonTokenFile, "token-file", "", "", "File holding a static GraphQL token")
+	configAddCmd.Flags().StringVarP(&lagoonTokenStore, "token-store", "", "", "Where to store tokens: file, encrypted-file or keyring (default file)")
+	configAddCmd.Flags().StringVarP(&lagoonTokenHelper, "token-helper", "", "", "Credential helper command which prints a GraphQL token")
 	configFeatureSwitch.Flags().StringVarP(&updateCheck, "disable-update-check", "", "", "Enable or disable checking of updates (true/false)")
 	configFeatureSwitch.Flags().StringVarP(&projectDirectoryCheck, "disable-project-directory-check", "", "", "Enable or disable checking of local directory for lagoon project (true/false)")
 }
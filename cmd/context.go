@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/amazeeio/lagoon-cli/pkg/output"
+)
+
+// interruptExitCode is the conventional exit code of a process terminated by
+// SIGINT.
+const interruptExitCode = 130
+
+// cmdTimeout is the value of the global --timeout flag.
+var cmdTimeout time.Duration
+
+// cmdContext is the context passed to every API call made by a command. It is
+// cancelled on SIGINT, and has a deadline if --timeout is set.
+var cmdContext = context.Background()
+
+// cancelTimeout releases the resources of the deadline set by initContext.
+var cancelTimeout context.CancelFunc = func() {}
+
+// notifyInterrupt returns a copy of parent which is cancelled when the process
+// receives SIGINT. After the first SIGINT the default signal behaviour is
+// restored, so a second SIGINT terminates the process immediately. The
+// returned stop function releases the signal handler.
+func notifyInterrupt(parent context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(parent)
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		select {
+		case <-interrupt:
+			signal.Stop(interrupt)
+			output.RenderInfo("Interrupted, cancelling the current operation", outputOptions)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, func() {
+		signal.Stop(interrupt)
+		cancel()
+	}
+}
+
+// initContext applies the --timeout flag to cmdContext. It is called by cobra
+// once the flags have been parsed.
+func initContext() {
+	if cmdTimeout > 0 {
+		cmdContext, cancelTimeout = context.WithTimeout(cmdContext, cmdTimeout)
+	}
+}
+
+// contextError returns a friendlier error than err if ctx was cancelled or its
+// deadline exceeded, and err otherwise.
+func contextError(ctx context.Context, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(ctx.Err(), context.Canceled):
+		return errOperationCancelled
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return fmt.Errorf("operation timed out after %v, try increasing --timeout",
+			cmdTimeout)
+	}
+	return err
+}
+
+// errOperationCancelled is returned by contextError if the command was
+// interrupted.
+var errOperationCancelled = errors.New("operation cancelled")
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+func TestContextError(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	apiErr := errors.New("graphql: bad request")
+	var testCases = map[string]struct {
+		ctx       context.Context
+		err       error
+		expectErr string
+	}{
+		"noError": {ctx: cancelled},
+		"passThrough": {
+			ctx:       context.Background(),
+			err:       apiErr,
+			expectErr: "graphql: bad request",
+		},
+		"cancelled": {
+			ctx:       cancelled,
+			err:       apiErr,
+			expectErr: "operation cancelled",
+		},
+		"timeout": {
+			ctx:       expired,
+			err:       apiErr,
+			expectErr: "operation timed out after 1m0s, try increasing --timeout",
+		},
+	}
+	cmdTimeout = time.Minute
+	defer func() { cmdTimeout = 0 }()
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			err := contextError(tc.ctx, tc.err)
+			if tc.expectErr == "" {
+				if err != nil {
+					tt.Fatalf("expected nil, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectErr {
+				tt.Fatalf("expected error %q, got %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestErrorExitCode(t *testing.T) {
+	var testCases = map[string]struct {
+		err    error
+		expect int
+	}{
+		"generic":      {err: errors.New("oops"), expect: 1},
+		"cancelled":    {err: errOperationCancelled, expect: interruptExitCode},
+		"unauthorized": {err: lagoon.ErrUnauthorized, expect: unauthorizedExitCode},
+		"notFound": {
+			err:    fmt.Errorf(`project "%s" %w`, "bananas", lagoon.ErrNotFound),
+			expect: notFoundExitCode,
+		},
+		"exist":     {err: lagoon.ErrExist, expect: existExitCode},
+		"forbidden": {err: lagoon.ErrForbidden, expect: forbiddenExitCode},
+		"deployment": {
+			err: deploymentResult(&schema.Deployment{
+				Name: "lagoon-build-abc", Status: "failed"}),
+			expect: deploymentExitCode,
+		},
+		"task": {
+			err:    taskResult(&schema.Task{ID: 42, Status: "failed"}),
+			expect: taskExitCode,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			if code := errorExitCode(tc.err); code != tc.expect {
+				tt.Fatalf("expected exit code %d, got %d", tc.expect, code)
+			}
+		})
+	}
+}
@@ -4,7 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -41,9 +45,13 @@ var deployCmd = &cobra.Command{
 }
 
 var deployBranchCmd = &cobra.Command{
-	Use:     "branch",
-	Short:   "Deploy a latest branch",
-	Long:    "Deploy a latest branch",
+	Use:   "branch",
+	Short: "Deploy a latest branch",
+	Long: `Deploy a latest branch, or the commit of the branch given with --ref.
+
+With --wait, the command waits until the deployment has finished, printing its
+status as it changes. It fails if the deployment failed, errored or was
+cancelled. Use --timeout to limit how long to wait.`,
 	Aliases: []string{"b"},
 	Run: func(cmd *cobra.Command, args []string) {
 		validateToken(viper.GetString("current")) // get a new token if the current one is invalid
@@ -53,11 +61,25 @@ var deployBranchCmd = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		if yesNo(fmt.Sprintf("You are attempting to deploy branch '%s' for project '%s', are you sure?", deployBranch.Branch, cmdProjectName)) {
-			deployResult, err := eClient.DeployEnvironmentBranch(cmdProjectName, deployBranch.Branch)
+		branch := fmt.Sprintf("branch '%s'", deployBranch.Branch)
+		if deployBranchRef != "" {
+			branch = fmt.Sprintf("commit '%s' of branch '%s'", deployBranchRef, deployBranch.Branch)
+		}
+		if yesNo(fmt.Sprintf("You are attempting to deploy %s for project '%s', are you sure?", branch, cmdProjectName)) {
+			known := deployedNames(deployBranch.Branch)
+			var deployResult string
+			err := newLagoonClient().DeployEnvironmentBranch(cmdContext, &schema.DeployEnvironmentBranchInput{
+				Project:    schema.ProjectInput{Name: cmdProjectName},
+				BranchName: deployBranch.Branch,
+				BranchRef:  deployBranchRef,
+			}, &deployResult)
 			handleError(err)
+			if deployWait {
+				handleError(waitForDeployment(deployBranch.Branch, known, deployResult))
+				return
+			}
 			resultData := output.Result{
-				Result: string(deployResult),
+				Result: deployResult,
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -69,7 +91,12 @@ var deployPromoteCmd = &cobra.Command{
 	Use:     "promote",
 	Aliases: []string{"p"},
 	Short:   "Promote an environment",
-	Long:    "Promote one environment to another",
+	Long: `Promote one environment to another.
+
+With --wait, the command waits until the deployment of the destination
+environment has finished, printing its status as it changes. It fails if the
+deployment failed, errored or was cancelled. Use --timeout to limit how long
+to wait.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		validateToken(viper.GetString("current")) // get a new token if the current one is invalid
 		promoteEnv := parseDeployFlags(*cmd.Flags())
@@ -79,10 +106,23 @@ var deployPromoteCmd = &cobra.Command{
 			os.Exit(1)
 		}
 		if yesNo(fmt.Sprintf("You are attempting to promote environment '%s' to '%s' for project '%s', are you sure?", promoteEnv.Source, promoteEnv.Destination, cmdProjectName)) {
-			deployResult, err := eClient.PromoteEnvironment(cmdProjectName, promoteEnv.Source, promoteEnv.Destination)
+			known := deployedNames(promoteEnv.Destination)
+			var deployResult string
+			err := newLagoonClient().DeployEnvironmentPromote(cmdContext, &schema.DeployEnvironmentPromoteInput{
+				SourceEnvironment: schema.EnvironmentInput{
+					Name:    promoteEnv.Source,
+					Project: &schema.ProjectInput{Name: cmdProjectName},
+				},
+				Project:                schema.ProjectInput{Name: cmdProjectName},
+				DestinationEnvironment: promoteEnv.Destination,
+			}, &deployResult)
 			handleError(err)
+			if deployWait {
+				handleError(waitForDeployment(promoteEnv.Destination, known, deployResult))
+				return
+			}
 			resultData := output.Result{
-				Result: string(deployResult),
+				Result: deployResult,
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -90,19 +130,116 @@ var deployPromoteCmd = &cobra.Command{
 	},
 }
 
+var deployPullrequestCmd = &cobra.Command{
+	Use:     "pullrequest",
+	Aliases: []string{"pr"},
+	Short:   "Deploy a pull request",
+	Long: `Deploy a pull request to the environment pr-<number>.
+
+With --wait, the command waits until the deployment has finished, printing its
+status as it changes. It fails if the deployment failed, errored or was
+cancelled. Use --timeout to limit how long to wait.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		validateToken(viper.GetString("current")) // get a new token if the current one is invalid
+		pr := deployPullrequest
+		if cmdProjectName == "" || pr.Number == 0 || pr.Title == "" ||
+			pr.BaseBranchName == "" || pr.BaseBranchRef == "" ||
+			pr.HeadBranchName == "" || pr.HeadBranchRef == "" {
+			fmt.Println("Missing arguments: Project name, pull request number, title, base branch, base branch ref, head branch or head branch ref is not defined")
+			cmd.Help()
+			os.Exit(1)
+		}
+		if yesNo(fmt.Sprintf("You are attempting to deploy pull request %d '%s' for project '%s', are you sure?", pr.Number, pr.Title, cmdProjectName)) {
+			environment := fmt.Sprintf("pr-%d", pr.Number)
+			known := deployedNames(environment)
+			pr.Project = schema.ProjectInput{Name: cmdProjectName}
+			var deployResult string
+			err := newLagoonClient().DeployEnvironmentPullrequest(cmdContext, &pr, &deployResult)
+			handleError(err)
+			if deployWait {
+				handleError(waitForDeployment(environment, known, deployResult))
+				return
+			}
+			resultData := output.Result{
+				Result: deployResult,
+			}
+			output.RenderResult(resultData, outputOptions)
+		}
+
+	},
+}
+
+var deployBranchRef string
+
+var deployPullrequest schema.DeployEnvironmentPullrequestInput
+
 var (
 	promoteSourceEnv string
 	promoteDestEnv   string
 )
 
+var (
+	deployWait         bool
+	deployPollInterval time.Duration
+)
+
+// deployedNames returns the names of the current deployments of an
+// environment if --wait is set, so that the deployment started by the command
+// can be found.
+func deployedNames(environment string) map[string]bool {
+	if !deployWait {
+		return nil
+	}
+	known, err := lagoon.DeploymentNames(cmdContext, newLagoonClient(),
+		cmdProjectName, environment)
+	handleError(err)
+	return known
+}
+
+// waitForDeployment waits for the deployment of an environment which isn't in
+// known to finish, and renders the result of the deploy command along with
+// the deployment.
+func waitForDeployment(environment string, known map[string]bool,
+	result string) error {
+	output.RenderInfo(fmt.Sprintf("Waiting for the deployment of environment %s", environment), outputOptions)
+	deployment, err := lagoon.WaitForDeployment(cmdContext, newLagoonClient(),
+		cmdProjectName, environment, known, deployPollInterval,
+		func(deployment *schema.Deployment) {
+			output.RenderInfo(fmt.Sprintf("Deployment %s is %s", deployment.Name,
+				strings.ToLower(string(deployment.Status))), outputOptions)
+		})
+	if err != nil {
+		return err
+	}
+	if err = deploymentResult(deployment); err != nil {
+		return err
+	}
+	output.RenderResult(output.Result{
+		Result: result,
+		ResultData: map[string]interface{}{
+			"deployment": deployment.Name,
+			"status":     deployment.Status,
+		},
+	}, outputOptions)
+	return nil
+}
+
 func init() {
 	deployCmd.AddCommand(deployBranchCmd)
 	deployCmd.AddCommand(deployPromoteCmd)
+	deployCmd.AddCommand(deployPullrequestCmd)
 	deployBranchCmd.Flags().StringVarP(&deployBranchName, "branch", "b", "", "branch name")
+	deployBranchCmd.Flags().StringVarP(&deployBranchRef, "ref", "r", "", "git SHA of the commit to deploy, instead of the latest commit of the branch")
 	deployPromoteCmd.Flags().StringVarP(&promoteDestEnv, "destination", "d", "", "destination environment name")
 	deployPromoteCmd.Flags().StringVarP(&promoteSourceEnv, "source", "s", "", "source environment name")
+	deployPullrequestCmd.Flags().UintVarP(&deployPullrequest.Number, "number", "n", 0, "pull request number")
+	deployPullrequestCmd.Flags().StringVarP(&deployPullrequest.Title, "title", "t", "", "pull request title")
+	deployPullrequestCmd.Flags().StringVarP(&deployPullrequest.BaseBranchName, "base-branch", "B", "", "base branch name")
+	deployPullrequestCmd.Flags().StringVar(&deployPullrequest.BaseBranchRef, "base-branch-ref", "", "git SHA of the base branch")
+	deployPullrequestCmd.Flags().StringVarP(&deployPullrequest.HeadBranchName, "head-branch", "H", "", "head branch name")
+	deployPullrequestCmd.Flags().StringVar(&deployPullrequest.HeadBranchRef, "head-branch-ref", "", "git SHA of the head branch")
+	for _, c := range []*cobra.Command{deployBranchCmd, deployPromoteCmd, deployPullrequestCmd} {
+		c.Flags().BoolVarP(&deployWait, "wait", "", false, "Wait for the deployment to finish, and fail if it didn't complete")
+		c.Flags().DurationVarP(&deployPollInterval, "poll-interval", "", 10*time.Second, "How often to check the status of the deployment with --wait")
+	}
 }
-
-/* @TODO
-Need to be able to support more than just deploying the latest branch, like deploying pull requests?
-*/
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// diffChangesExitCode is the exit code of the diff command when there are
+// changes pending.
+const diffChangesExitCode = 2
+
+var diffCmd = &cobra.Command{
+	Use:    "diff",
+	Hidden: false,
+	Short:  "Show the changes applying a config from a yaml file would make",
+	Long: `Show the changes applying a config from a yaml file would make.
+The config is compared with the current state of Lagoon, and the changes which
+apply would make are displayed without making them. Use --prune to also show
+the objects which apply --prune would remove.
+
+By default the changes are displayed as a table. Use --output-json for a
+structured list of changes, or --unified for a unified diff between the
+current state of the projects in the config as exported from Lagoon and the
+config itself. Secret values such as envVariable values, project privateKeys
+and notification webhooks are shown as (sensitive) in every output format.
+
+The exit code is 0 if there are no changes and 2 if there are changes pending.
+Errors have the same exit codes as other commands: 3 if the Lagoon API rejected
+the token, 4 if an object was not found, 6 if permission was denied, 130 if
+cancelled with Ctrl-C, and 1 for any other error. See the exit code table in
+the documentation index.`,
+	PreRunE: func(_ *cobra.Command, _ []string) error {
+		return validateTokenE(viper.GetString("current"))
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		diffFile, err := cmd.Flags().GetString("file")
+		if err != nil {
+			return err
+		}
+		prune, err := cmd.Flags().GetBool("prune")
+		if err != nil {
+			return err
+		}
+		unified, err := cmd.Flags().GetBool("unified")
+		if err != nil {
+			return err
+		}
+		exclude, err := cmd.Flags().GetStringSlice("exclude")
+		if err != nil {
+			return err
+		}
+		openshiftID, err := cmd.Flags().GetUint("openshiftID")
+		if err != nil {
+			return err
+		}
+		current := viper.GetString("current")
+		viper.SetDefault("lagoons."+current+".version", "1.0.0")
+		lc := newLagoonClient()
+
+		file, err := os.Open(diffFile)
+		if err != nil {
+			return fmt.Errorf("couldn't open file: %w", err)
+		}
+		defer file.Close()
+		config, err := lagoon.ParseConfig(file)
+		if err != nil {
+			return err
+		}
+
+		plan, err := lagoon.PlanApply(
+			cmdContext, lc, config, prune, openshiftID)
+		if err != nil {
+			return err
+		}
+		switch {
+		case unified:
+			diff, err := lagoon.DiffConfig(
+				cmdContext, lc, config, sliceToMap(exclude))
+			if err != nil {
+				return err
+			}
+			fmt.Print(diff)
+		case outputOptions.JSON:
+			output.RenderJSON(plan, outputOptions)
+		case len(plan.Changes) == 0:
+			output.RenderInfo("no changes required", outputOptions)
+		default:
+			output.RenderOutput(planTable(plan), outputOptions)
+		}
+
+		if len(plan.Changes) > 0 {
+			// changes pending is not an error, so don't report it as one
+			cmd.SilenceErrors = true
+			cmd.SilenceUsage = true
+			return exitCodeError(diffChangesExitCode)
+		}
+		return nil
+	},
+}
+
+func init() {
+	diffCmd.Flags().StringP("file", "f", "",
+		"path to the file to compare")
+	diffCmd.Flags().Bool("prune", false,
+		"show objects attached to those in the file which are not in the file")
+	diffCmd.Flags().BoolP("unified", "u", false,
+		"display the differences as a unified diff")
+	diffCmd.Flags().StringSlice("exclude", []string{"project-private-keys"},
+		`Exclude data from the unified diff. Valid options (others are ignored): users, project-users, groups, notifications, project-private-keys`)
+	diffCmd.Flags().Uint("openshiftID", 0,
+		"ID of the openshift to target when creating or updating projects")
+	if err := diffCmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var downloadTaskID uint
+var downloadDir string
+
+var downloadCmd = &cobra.Command{
+	Use:   "download",
+	Short: "Download files from Lagoon",
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		return validateTokenE(viper.GetString("current"))
+	},
+}
+
+var downloadTaskFilesCmd = &cobra.Command{
+	Use:     "task-files",
+	Aliases: []string{"tf"},
+	Short:   "Download the files of a task",
+	Long: `Download the files attached to a task, such as the dump of a drush-sqldump
+task, to a local directory. Existing files with the same name are overwritten.
+If several files of the task have the same name, each of them is downloaded to
+a subdirectory named after the ID of the file.
+The size and SHA-256 checksum of each file is shown once it is downloaded.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if downloadTaskID == 0 {
+			return errors.New("Missing arguments: Task ID is not defined")
+		}
+		task, err := lagoon.GetTask(cmdContext, newLagoonClient(), downloadTaskID)
+		if err != nil {
+			return err
+		}
+		if len(task.Files) == 0 {
+			return fmt.Errorf("task %d has no files", task.ID)
+		}
+		dirs, err := lagoon.TaskFileDirs(task.Files, downloadDir)
+		if err != nil {
+			return err
+		}
+		httpClient, err := lagoonTLSConfig(viper.GetString("current")).HTTPClient()
+		if err != nil {
+			return fmt.Errorf("invalid TLS config: %w", err)
+		}
+		data := []output.Data{}
+		for _, file := range task.Files {
+			if err = os.MkdirAll(dirs[file.ID], 0755); err != nil {
+				return err
+			}
+			downloaded, err := lagoon.DownloadTaskFile(cmdContext,
+				httpClient, file.Download, file.Filename, dirs[file.ID],
+				func(written, total int64) {
+					downloadProgress(file.Filename, written, total)
+				})
+			fmt.Fprintln(os.Stderr)
+			if err != nil {
+				return err
+			}
+			data = append(data, []string{
+				file.Filename,
+				downloaded.Path,
+				fmt.Sprintf("%d", downloaded.Size),
+				downloaded.SHA256,
+			})
+		}
+		output.RenderOutput(output.Table{
+			Header: []string{"Filename", "Path", "Size", "SHA256"},
+			Data:   data,
+		}, outputOptions)
+		return nil
+	},
+}
+
+// downloadProgress writes the progress of a download to stderr, overwriting
+// the previous progress of the file.
+func downloadProgress(filename string, written, total int64) {
+	if total > 0 {
+		fmt.Fprintf(os.Stderr, "\rDownloading %s: %d/%d bytes (%d%%)",
+			filename, written, total, written*100/total)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "\rDownloading %s: %d bytes", filename, written)
+}
+
+func init() {
+	downloadCmd.AddCommand(downloadTaskFilesCmd)
+	downloadTaskFilesCmd.Flags().UintVarP(&downloadTaskID, "id", "I", 0, "The ID of the task")
+	downloadTaskFilesCmd.Flags().StringVarP(&downloadDir, "dir", "d", ".", "The directory to download the files to")
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/amazeeio/lagoon-cli/internal/schema"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
 )
@@ -39,10 +40,15 @@ var deleteEnvCmd = &cobra.Command{
 			os.Exit(1)
 		}
 		if yesNo(fmt.Sprintf("You are attempting to delete environment '%s' from project '%s', are you sure?", cmdProjectEnvironment, cmdProjectName)) {
-			projectByName, err := eClient.DeleteEnvironment(cmdProjectName, cmdProjectEnvironment)
+			var result string
+			err := newLagoonClient().DeleteEnvironment(cmdContext, &schema.DeleteEnvironmentInput{
+				Name:    cmdProjectEnvironment,
+				Project: cmdProjectName,
+				Execute: true,
+			}, &result)
 			handleError(err)
 			resultData := output.Result{
-				Result: string(projectByName),
+				Result: result,
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -4,12 +4,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/amazeeio/lagoon-cli/internal/helpers"
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
 	"github.com/amazeeio/lagoon-cli/pkg/api"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"golang.org/x/crypto/ssh"
 )
 
 // GetFlags .
@@ -32,6 +37,11 @@ func parseGetFlags(flags pflag.FlagSet) GetFlags {
 	return parsedFlags
 }
 
+var (
+	getTaskID   uint
+	getTaskLogs bool
+)
+
 var getCmd = &cobra.Command{
 	Use:     "get",
 	Aliases: []string{"g"},
@@ -52,17 +62,12 @@ var getProjectCmd = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		returnedJSON, err := pClient.GetProjectInfo(getProjectFlags.Project)
-		if err != nil {
-			output.RenderError(err.Error(), outputOptions)
-			os.Exit(1)
-		}
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		project, err := lagoon.GetProjectInfo(cmdContext, newLagoonClient(), getProjectFlags.Project)
 		if err != nil {
 			output.RenderError(err.Error(), outputOptions)
 			os.Exit(1)
 		}
+		dataMain := projectInfoTable(project)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -83,21 +88,16 @@ var getDeploymentCmd = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		returnedJSON, err := eClient.GetDeploymentLog(getProjectFlags.RemoteID)
+		deployment := schema.Deployment{}
+		err := newLagoonClient().DeploymentByRemoteID(cmdContext, getProjectFlags.RemoteID, &deployment)
 		if err != nil {
 			output.RenderError(err.Error(), outputOptions)
 			os.Exit(1)
 		}
-		if string(returnedJSON) == "null" {
+		if deployment.ID == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
 		}
-		var deployment api.Deployment
-		err = json.Unmarshal([]byte(returnedJSON), &deployment)
-		if err != nil {
-			output.RenderError(err.Error(), outputOptions)
-			os.Exit(1)
-		}
 		if deployment.BuildLog != "" {
 			fmt.Println(deployment.BuildLog)
 		} else {
@@ -107,6 +107,32 @@ var getDeploymentCmd = &cobra.Command{
 	},
 }
 
+var getTaskCmd = &cobra.Command{
+	Use:     "task",
+	Aliases: []string{"t"},
+	Short:   "Get the status or logs of a task by id",
+	Long: `Get the status of a task by the id returned by the run commands, or its logs
+with --logs.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if getTaskID == 0 {
+			fmt.Println("Missing arguments: Task ID is not defined")
+			cmd.Help()
+			os.Exit(1)
+		}
+		task, err := lagoon.GetTask(cmdContext, newLagoonClient(), getTaskID)
+		handleError(err)
+		if getTaskLogs {
+			if task.Logs != "" {
+				fmt.Println(task.Logs)
+			} else {
+				fmt.Println("Log data is not available")
+			}
+			return
+		}
+		output.RenderOutput(tasksTable([]schema.Task{*task}), outputOptions)
+	},
+}
+
 var getEnvironmentCmd = &cobra.Command{
 	Use:     "environment",
 	Aliases: []string{"e"},
@@ -117,11 +143,10 @@ var getEnvironmentCmd = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		returnedJSON, err := eClient.GetEnvironmentInfo(cmdProjectName, cmdProjectEnvironment)
-		handleError(err)
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		environment, err := lagoon.GetEnvironment(cmdContext, newLagoonClient(),
+			cmdProjectName, cmdProjectEnvironment)
 		handleError(err)
+		dataMain := environmentInfoTable(environment)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -142,10 +167,10 @@ var getProjectKeyCmd = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		returnedJSON, err := pClient.GetProjectKey(getProjectFlags.Project, revealValue)
+		project := schema.Project{}
+		err := newLagoonClient().ProjectByName(cmdContext, getProjectFlags.Project, &project)
 		handleError(err)
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		dataMain, err := projectKeyTable(&project, revealValue)
 		handleError(err)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
@@ -156,13 +181,93 @@ var getProjectKeyCmd = &cobra.Command{
 	},
 }
 
+// projectInfoTable returns a table of basic info about a project.
+func projectInfoTable(project *schema.Project) output.Table {
+	// count the current dev environments in a project
+	var currentDevEnvironments = 0
+	var projectRoute = "none"
+	for _, environment := range project.Environments {
+		if isEnvType(environment.EnvironmentType, api.DevelopmentEnv) {
+			currentDevEnvironments++
+		}
+		if isEnvType(environment.EnvironmentType, api.ProductionEnv) {
+			projectRoute = environment.Route
+		}
+	}
+	return output.Table{
+		Header: []string{"ID", "ProjectName", "GitURL", "Branches", "PullRequests", "ProductionRoute", "DevEnvironments", "DevEnvLimit", "ProductionEnv", "AutoIdle"},
+		Data: []output.Data{
+			[]string{
+				fmt.Sprintf("%v", project.ID),
+				project.Name,
+				project.GitURL,
+				project.Branches,
+				project.PullRequests,
+				projectRoute,
+				fmt.Sprintf("%v/%v", currentDevEnvironments, project.DevelopmentEnvironmentsLimit),
+				fmt.Sprintf("%v", project.DevelopmentEnvironmentsLimit),
+				project.ProductionEnvironment,
+				fmt.Sprintf("%v", project.AutoIdle),
+			},
+		},
+	}
+}
+
+// environmentInfoTable returns a table of basic info about an environment.
+func environmentInfoTable(environment *schema.Environment) output.Table {
+	return output.Table{
+		Header: []string{"ID", "EnvironmentName", "EnvironmentType", "DeployType", "Created", "Route", "Routes", "MonitoringURLS", "AutoIdle", "DeployTitle", "DeployBaseRef", "DeployHeadRef"},
+		Data: []output.Data{
+			[]string{
+				fmt.Sprintf("%v", environment.ID),
+				helpers.ReturnNonEmptyString(environment.Name),
+				helpers.ReturnNonEmptyString(string(environment.EnvironmentType)),
+				helpers.ReturnNonEmptyString(string(environment.DeployType)),
+				helpers.ReturnNonEmptyString(environment.Created),
+				helpers.ReturnNonEmptyString(environment.Route),
+				helpers.ReturnNonEmptyString(environment.Routes),
+				helpers.ReturnNonEmptyString(environment.MonitoringURLs),
+				fmt.Sprintf("%v", environment.AutoIdle),
+				helpers.ReturnNonEmptyString(environment.DeployTitle),
+				helpers.ReturnNonEmptyString(environment.DeployBaseRef),
+				helpers.ReturnNonEmptyString(environment.DeployHeadRef),
+			},
+		},
+	}
+}
+
+// projectKeyTable returns a table containing the public key of a project,
+// and its private key if reveal is true.
+func projectKeyTable(project *schema.Project, reveal bool) (output.Table, error) {
+	signer, err := ssh.ParsePrivateKey([]byte(project.PrivateKey))
+	if err != nil {
+		return output.Table{}, err
+	}
+	// get the key, but strip the newlines we don't need
+	projectData := []string{
+		strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(signer.PublicKey())), "\n"),
+	}
+	dataMain := output.Table{
+		Header: []string{"PublicKey"},
+	}
+	if reveal {
+		projectData = append(projectData, strings.TrimSuffix(project.PrivateKey, "\n"))
+		dataMain.Header = append(dataMain.Header, "PrivateKey")
+	}
+	dataMain.Data = []output.Data{projectData}
+	return dataMain, nil
+}
+
 func init() {
 	getCmd.AddCommand(getAllUserKeysCmd)
 	getCmd.AddCommand(getDeploymentCmd)
 	getCmd.AddCommand(getEnvironmentCmd)
 	getCmd.AddCommand(getProjectCmd)
 	getCmd.AddCommand(getProjectKeyCmd)
+	getCmd.AddCommand(getTaskCmd)
 	getCmd.AddCommand(getUserKeysCmd)
 	getProjectKeyCmd.Flags().BoolVarP(&revealValue, "reveal", "", false, "Reveal the variable values")
 	getDeploymentCmd.Flags().StringVarP(&remoteID, "remoteid", "R", "", "The remote ID of the deployment")
+	getTaskCmd.Flags().UintVarP(&getTaskID, "id", "I", 0, "The ID of the task")
+	getTaskCmd.Flags().BoolVarP(&getTaskLogs, "logs", "L", false, "Show the logs of the task instead of its status")
 }
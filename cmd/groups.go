@@ -1,51 +1,33 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
 
+	"github.com/amazeeio/lagoon-cli/internal/schema"
 	"github.com/amazeeio/lagoon-cli/pkg/api"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/pflag"
 )
 
-func parseGroup(flags pflag.FlagSet) api.Group {
-	configMap := make(map[string]interface{})
-	flags.VisitAll(func(f *pflag.Flag) {
-		if flags.Changed(f.Name) {
-			configMap[f.Name] = f.Value
-		}
-	})
-	jsonStr, _ := json.Marshal(configMap)
-	parsedFlags := api.Group{}
-	json.Unmarshal(jsonStr, &parsedFlags)
-	return parsedFlags
-}
-
 var addGroupCmd = &cobra.Command{
 	Use:     "group",
 	Aliases: []string{"g"},
 	Short:   "Add a group to lagoon",
 	Run: func(cmd *cobra.Command, args []string) {
-		groupFlags := parseGroup(*cmd.Flags())
-		if groupFlags.Name == "" {
+		if groupName == "" {
 			fmt.Println("Missing arguments: Group name is not defined")
 			cmd.Help()
 			os.Exit(1)
 		}
-		var customReqResult []byte
-		var err error
-		customReqResult, err = uClient.AddGroup(groupFlags)
-		handleError(err)
-		returnResultData := map[string]interface{}{}
-		err = json.Unmarshal([]byte(customReqResult), &returnResultData)
+		group := schema.Group{}
+		err := newLagoonClient().AddGroup(cmdContext,
+			&schema.AddGroupInput{Name: groupName}, &group)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: returnResultData,
+			ResultData: map[string]interface{}{"id": group.ID, "name": group.Name},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
@@ -69,30 +51,22 @@ var addUserToGroupCmd = &cobra.Command{
 		} else if strings.EqualFold(string(groupRole), "owner") {
 			roleType = api.OwnerRole
 		}
-		userGroupRole := api.UserGroupRole{
-			User: api.User{
-				Email: userEmail,
-			},
-			Group: api.Group{
-				Name: groupName,
-			},
-			Role: roleType,
+		userGroupRole := schema.UserGroupRoleInput{
+			UserEmail: userEmail,
+			GroupName: groupName,
+			GroupRole: roleType,
 		}
-		if userGroupRole.User.Email == "" || userGroupRole.Group.Name == "" || userGroupRole.Role == "" {
+		if userGroupRole.UserEmail == "" || userGroupRole.GroupName == "" || userGroupRole.GroupRole == "" {
 			output.RenderError("Missing arguments: Email address, group name, or role is not defined", outputOptions)
 			cmd.Help()
 			os.Exit(1)
 		}
-		var customReqResult []byte
-		var err error
-		customReqResult, err = uClient.AddUserToGroup(userGroupRole)
-		handleError(err)
-		returnResultData := map[string]interface{}{}
-		err = json.Unmarshal([]byte(customReqResult), &returnResultData)
+		group := schema.Group{}
+		err := newLagoonClient().AddUserToGroup(cmdContext, &userGroupRole, &group)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: returnResultData,
+			ResultData: map[string]interface{}{"id": group.ID, "name": group.Name},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
@@ -103,31 +77,27 @@ var addProjectToGroupCmd = &cobra.Command{
 	Aliases: []string{"pg"},
 	Short:   "Add a project to a group in lagoon",
 	Run: func(cmd *cobra.Command, args []string) {
-		projectGroup := api.ProjectGroups{
-			Project: api.Project{
+		projectGroup := schema.ProjectGroupsInput{
+			Project: schema.ProjectInput{
 				Name: cmdProjectName,
 			},
-			Groups: []api.Group{
+			Groups: []schema.GroupInput{
 				{
 					Name: groupName,
 				},
 			},
 		}
-		if projectGroup.Project.Name == "" || len(projectGroup.Groups) == 0 {
+		if projectGroup.Project.Name == "" || groupName == "" {
 			output.RenderError("Missing arguments: Project name or group name is not defined", outputOptions)
 			cmd.Help()
 			os.Exit(1)
 		}
-		var customReqResult []byte
-		var err error
-		customReqResult, err = uClient.AddProjectToGroup(projectGroup)
-		handleError(err)
-		returnResultData := map[string]interface{}{}
-		err = json.Unmarshal([]byte(customReqResult), &returnResultData)
+		project := schema.Project{}
+		err := newLagoonClient().AddGroupsToProject(cmdContext, &projectGroup, &project)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: returnResultData,
+			ResultData: map[string]interface{}{"id": project.ID, "name": project.Name},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
@@ -138,30 +108,22 @@ var deleteUserFromGroupCmd = &cobra.Command{
 	Aliases: []string{"ug"},
 	Short:   "Delete a user from a group in lagoon",
 	Run: func(cmd *cobra.Command, args []string) {
-		userGroupRole := api.UserGroup{
-			User: api.User{
-				Email: userEmail,
-			},
-			Group: api.Group{
-				Name: groupName,
-			},
+		userGroup := schema.UserGroupInput{
+			UserEmail: userEmail,
+			GroupName: groupName,
 		}
-		if userGroupRole.User.Email == "" || userGroupRole.Group.Name == "" {
+		if userGroup.UserEmail == "" || userGroup.GroupName == "" {
 			output.RenderError("Missing arguments: Email address or group name is not defined", outputOptions)
 			cmd.Help()
 			os.Exit(1)
 		}
-		var customReqResult []byte
-		var err error
-		if yesNo(fmt.Sprintf("You are attempting to delete user '%s' from group '%s', are you sure?", userGroupRole.User.Email, userGroupRole.Group.Name)) {
-			customReqResult, err = uClient.RemoveUserFromGroup(userGroupRole)
-			handleError(err)
-			returnResultData := map[string]interface{}{}
-			err = json.Unmarshal([]byte(customReqResult), &returnResultData)
+		if yesNo(fmt.Sprintf("You are attempting to delete user '%s' from group '%s', are you sure?", userGroup.UserEmail, userGroup.GroupName)) {
+			group := schema.Group{}
+			err := newLagoonClient().RemoveUserFromGroup(cmdContext, &userGroup, &group)
 			handleError(err)
 			resultData := output.Result{
 				Result:     "success",
-				ResultData: returnResultData,
+				ResultData: map[string]interface{}{"id": group.ID, "name": group.Name},
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -173,32 +135,28 @@ var deleteProjectFromGroupCmd = &cobra.Command{
 	Aliases: []string{"pg"},
 	Short:   "Delete a project from a group in lagoon",
 	Run: func(cmd *cobra.Command, args []string) {
-		projectGroup := api.ProjectGroups{
-			Project: api.Project{
+		projectGroup := schema.ProjectGroupsInput{
+			Project: schema.ProjectInput{
 				Name: cmdProjectName,
 			},
-			Groups: []api.Group{
+			Groups: []schema.GroupInput{
 				{
 					Name: groupName,
 				},
 			},
 		}
-		if projectGroup.Project.Name == "" || len(projectGroup.Groups) == 0 {
+		if projectGroup.Project.Name == "" || groupName == "" {
 			output.RenderError("Missing arguments: Project name or group name is not defined", outputOptions)
 			cmd.Help()
 			os.Exit(1)
 		}
-		var customReqResult []byte
-		var err error
-		if yesNo(fmt.Sprintf("You are attempting to delete project '%s' from group '%s', are you sure?", projectGroup.Project.Name, projectGroup.Groups[0].Name)) {
-			customReqResult, err = uClient.RemoveGroupsFromProject(projectGroup)
-			handleError(err)
-			returnResultData := map[string]interface{}{}
-			err = json.Unmarshal([]byte(customReqResult), &returnResultData)
+		if yesNo(fmt.Sprintf("You are attempting to delete project '%s' from group '%s', are you sure?", projectGroup.Project.Name, groupName)) {
+			project := schema.Project{}
+			err := newLagoonClient().RemoveGroupsFromProject(cmdContext, &projectGroup, &project)
 			handleError(err)
 			resultData := output.Result{
 				Result:     "success",
-				ResultData: returnResultData,
+				ResultData: map[string]interface{}{"id": project.ID, "name": project.Name},
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -209,19 +167,19 @@ var deleteGroupCmd = &cobra.Command{
 	Aliases: []string{"g"},
 	Short:   "Delete a group from lagoon",
 	Run: func(cmd *cobra.Command, args []string) {
-		groupFlags := parseGroup(*cmd.Flags())
-		if groupFlags.Name == "" {
+		if groupName == "" {
 			fmt.Println("Missing arguments: Group name is not defined")
 			cmd.Help()
 			os.Exit(1)
 		}
-		var customReqResult []byte
-		var err error
-		if yesNo(fmt.Sprintf("You are attempting to delete group '%s', are you sure?", groupFlags.Name)) {
-			customReqResult, err = uClient.DeleteGroup(groupFlags)
+		if yesNo(fmt.Sprintf("You are attempting to delete group '%s', are you sure?", groupName)) {
+			var result string
+			err := newLagoonClient().DeleteGroup(cmdContext, &schema.DeleteGroupInput{
+				Group: schema.GroupInput{Name: groupName},
+			}, &result)
 			handleError(err)
 			resultData := output.Result{
-				Result: string(customReqResult),
+				Result: result,
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -1,12 +1,17 @@
 package cmd
 
 import (
-	"context"
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/amazeeio/lagoon-cli/internal/lagoon"
 	"github.com/amazeeio/lagoon-cli/internal/lagoon/client"
+	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -18,8 +23,52 @@ var importCmd = &cobra.Command{
 	Short:   "Import a config from a yaml file",
 	Long: `Import a config from a yaml file.
 By default this command will exit on encountering an error (such as an existing object).
-You can get it to continue anyway with --keep-going. To disable any prompts, use --force.`,
-	PreRunE: func(_ *cobra.Command, _ []string) error {
+You can get it to continue anyway with --keep-going. To disable any prompts, use --force.
+The file is validated before anything is imported (see 'lagoon config validate').
+Use --dry-run to validate the config and print the mutations which would be sent
+to Lagoon, without sending them.
+Each object created during import is recorded in a journal file, which is
+written to the path given by --journal, or else to
+lagoon-import-<timestamp>.journal in the current directory. The journal is only
+created once the first mutation is sent, so a dry-run, a cancelled import or an
+invalid config doesn't leave a journal behind. If an import fails partway, use
+--rollback <journal> to delete the objects it created, in reverse order.
+Use --parallel to create objects which don't depend on each other concurrently.
+With --template, --values or --set the file is rendered as a Go template before
+it is imported. Values from --values files and --set are available as .Values,
+and environment variables as .Env. For example:
+
+  projects:
+  {{- range .Values.projects }}
+  - name: {{ .name }}
+    gitUrl: git@github.com:{{ $.Values.org }}/{{ .name }}.git
+    productionEnvironment: {{ get . "production" "master" }}
+  {{- end }}
+
+The template functions env, default, required, get, quote, lower and upper are
+available. Use --render to print the rendered file without importing it; line
+numbers in validation errors refer to the rendered file.
+Secret values need not be written in the file in plaintext. Env variable values,
+project privateKeys and notification webhooks may instead be secret references,
+which are resolved when the file is imported:
+
+  value: {fromEnv: DB_PASS}          # an environment variable
+  value: {fromFile: ./secrets/key}   # a file, relative to the current directory
+  value: {fromCommand: pass show x}  # the output of a shell command
+
+Secret values are shown as (sensitive) in the output of --dry-run.`,
+	PreRunE: func(cmd *cobra.Command, _ []string) error {
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+		render, err := cmd.Flags().GetBool("render")
+		if err != nil {
+			return err
+		}
+		if dryRun || render {
+			return nil // no API access required
+		}
 		return validateTokenE(viper.GetString("current"))
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -27,6 +76,14 @@ You can get it to continue anyway with --keep-going. To disable any prompts, use
 		if err != nil {
 			return err
 		}
+		journalFile, err := cmd.Flags().GetString("journal")
+		if err != nil {
+			return err
+		}
+		rollbackFile, err := cmd.Flags().GetString("rollback")
+		if err != nil {
+			return err
+		}
 		keepGoing, err := cmd.Flags().GetBool("keep-going")
 		if err != nil {
 			return err
@@ -35,34 +92,193 @@ You can get it to continue anyway with --keep-going. To disable any prompts, use
 		if err != nil {
 			return err
 		}
-		debug, err := cmd.Flags().GetBool("debug")
+		parallel, err := cmd.Flags().GetInt("parallel")
+		if err != nil {
+			return err
+		}
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+		render, err := cmd.Flags().GetBool("render")
 		if err != nil {
 			return err
 		}
 
 		current := viper.GetString("current")
+		viper.SetDefault("lagoons."+current+".version", "1.0.0")
+		if rollbackFile != "" {
+			if dryRun {
+				return fmt.Errorf("--dry-run can't be used with --rollback")
+			}
+			return rollbackImport(rollbackFile, current, keepGoing)
+		}
+		if importFile == "" {
+			return fmt.Errorf(`required flag(s) "import-file" not set`)
+		}
+		data, err := readImportFile(cmd, importFile)
+		if err != nil {
+			return err
+		}
+		if render {
+			fmt.Print(string(data))
+			return nil
+		}
+		if openshiftID == 0 {
+			return fmt.Errorf(`required flag(s) "openshiftID" not set`)
+		}
+		if dryRun {
+			lc, recorder := client.NewDryRun(
+				viper.GetString("lagoons." + current + ".version"))
+			// import sequentially so that the recorded mutations are in order
+			err = lagoon.Import(cmdContext,
+				lc, bytes.NewReader(data), keepGoing, openshiftID, 1, nil)
+			renderRequests(recorder.Requests())
+			return err
+		}
+
 		if !yesNo(fmt.Sprintf(
 			`Are you sure you want to import config from %s into "%s" lagoon?`,
 			importFile, current)) {
 			return nil // user cancelled
 		}
 
-		viper.SetDefault("lagoons."+current+".version", "1.0.0")
-		lc := client.New(
-			viper.GetString("lagoons."+current+".graphql"),
-			viper.GetString("lagoons."+current+".token"),
-			viper.GetString("lagoons."+current+".version"),
-			debug)
+		lc := newLagoonClient()
 
-		file, err := os.Open(importFile)
-		if err != nil {
-			return fmt.Errorf("couldn't open file: %w", err)
+		if journalFile == "" {
+			journalFile = fmt.Sprintf("lagoon-import-%s.journal",
+				time.Now().Format("20060102T150405"))
+		}
+		if _, err = os.Stat(journalFile); err == nil {
+			return fmt.Errorf("couldn't create journal: %s exists", journalFile)
 		}
+		jf := &lazyFile{path: journalFile}
+		defer jf.Close()
+		journal := lagoon.NewJournal(jf)
 
-		return lagoon.Import(context.TODO(), lc, file, keepGoing, openshiftID)
+		err = lagoon.Import(cmdContext, lc, bytes.NewReader(data), keepGoing,
+			openshiftID, parallel, journal)
+		if jerr := journal.Err(); jerr != nil {
+			output.RenderError(
+				fmt.Sprintf("couldn't write journal: %v", jerr), outputOptions)
+		}
+		if jf.file != nil {
+			fmt.Fprintf(os.Stderr, "import journal written to %s\n", journalFile)
+		}
+		return err
 	},
 }
 
+// lazyFile is an io.WriteCloser which creates the file at path on the first
+// write, so that nothing is created if nothing is written. An existing file is
+// never overwritten.
+type lazyFile struct {
+	path string
+	file *os.File
+}
+
+func (f *lazyFile) Write(p []byte) (int, error) {
+	if f.file == nil {
+		file, err := os.OpenFile(f.path,
+			os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return 0, fmt.Errorf("couldn't create journal: %w", err)
+		}
+		f.file = file
+	}
+	return f.file.Write(p)
+}
+
+// Close closes the file if it was created.
+func (f *lazyFile) Close() error {
+	if f.file == nil {
+		return nil
+	}
+	return f.file.Close()
+}
+
+// readImportFile reads the file to import, rendering it as a template if
+// any of the template flags are set.
+func readImportFile(cmd *cobra.Command, importFile string) ([]byte, error) {
+	data, err := ioutil.ReadFile(importFile)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read file: %w", err)
+	}
+	tmpl, err := cmd.Flags().GetBool("template")
+	if err != nil {
+		return nil, err
+	}
+	valuesFiles, err := cmd.Flags().GetStringSlice("values")
+	if err != nil {
+		return nil, err
+	}
+	set, err := cmd.Flags().GetStringArray("set")
+	if err != nil {
+		return nil, err
+	}
+	if !tmpl && len(valuesFiles) == 0 && len(set) == 0 {
+		return data, nil
+	}
+	values, err := lagoon.ParseValues(valuesFiles, set)
+	if err != nil {
+		return nil, err
+	}
+	return lagoon.RenderConfig(data, values)
+}
+
+// rollbackImport deletes the objects recorded in an import journal.
+func rollbackImport(journalFile, current string, keepGoing bool) error {
+	file, err := os.Open(journalFile)
+	if err != nil {
+		return fmt.Errorf("couldn't open journal: %w", err)
+	}
+	defer file.Close()
+	entries, err := lagoon.ReadJournal(file)
+	if err != nil {
+		return err
+	}
+
+	if !yesNo(fmt.Sprintf(
+		`Are you sure you want to roll back the import in %s from "%s" lagoon?`,
+		journalFile, current)) {
+		return nil // user cancelled
+	}
+
+	lc := newLagoonClient()
+	return lagoon.Rollback(cmdContext, lc, entries, keepGoing)
+}
+
+// writeSecretsFile writes the secrets redacted from an export to a file which
+// only the user can read.
+func writeSecretsFile(secretsFile string, secrets map[string]string) error {
+	f, err := os.OpenFile(secretsFile,
+		os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("couldn't create secrets file: %w", err)
+	}
+	if err = lagoon.WriteSecrets(f, secrets); err != nil {
+		f.Close()
+		return fmt.Errorf("couldn't write secrets file: %w", err)
+	}
+	return f.Close()
+}
+
+// renderRequests prints the requests recorded during a dry-run.
+func renderRequests(requests []client.Request) {
+	if outputOptions.JSON {
+		output.RenderJSON(requests, outputOptions)
+		return
+	}
+	for i, r := range requests {
+		vars, err := json.MarshalIndent(r.Variables, "", "  ")
+		if err != nil {
+			vars = []byte(err.Error())
+		}
+		fmt.Printf("# mutation %d\n%s\n# variables\n%s\n\n",
+			i+1, strings.TrimSpace(r.Query), vars)
+	}
+}
+
 // convert a slice of strings to a set (as a map)
 func sliceToMap(s []string) map[string]bool {
 	m := map[string]bool{}
@@ -78,46 +294,91 @@ var exportCmd = &cobra.Command{
 	Hidden:  false,
 	Short:   "Export lagoon output to yaml",
 	Long: `Export lagoon output to yaml
-You must specify to export a specific project by using the '-p <project-name>' flag`,
+You must specify the projects to export by using the '-p <project-name>' flag,
+which may be repeated. Alternatively, use '--group <group-name>' (which may also
+be repeated) to export all projects in a group, or '--all' to export all
+projects. Groups, users, billing groups and notifications shared between the
+projects appear only once in the export.
+Use --redact-secrets to replace env variable values, project privateKeys and
+notification webhooks with {fromEnv: LAGOON_SECRET_...} secret references (see
+'lagoon import --help'). Use --secrets-file to also write the secret values to
+a separate file which can be sourced by a shell before importing the export.`,
 	PreRunE: func(_ *cobra.Command, _ []string) error {
 		return validateTokenE(viper.GetString("current"))
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		project, err := cmd.Flags().GetString("project")
+		projects, err := cmd.Flags().GetStringSlice("project")
 		if err != nil {
 			return err
 		}
-		if len(project) == 0 {
-			return fmt.Errorf("no project specified")
+		groups, err := cmd.Flags().GetStringSlice("group")
+		if err != nil {
+			return err
 		}
-		debug, err := cmd.Flags().GetBool("debug")
+		all, err := cmd.Flags().GetBool("all")
 		if err != nil {
 			return err
 		}
+		if len(projects) == 0 && len(groups) == 0 && !all {
+			return fmt.Errorf("no project specified")
+		}
 		exclude, err := cmd.Flags().GetStringSlice("exclude")
 		if err != nil {
 			return err
 		}
+		redact, err := cmd.Flags().GetBool("redact-secrets")
+		if err != nil {
+			return err
+		}
+		secretsFile, err := cmd.Flags().GetString("secrets-file")
+		if err != nil {
+			return err
+		}
 
 		current := viper.GetString("current")
+		var target string
+		switch {
+		case all:
+			target = "all projects"
+		case len(groups) > 0:
+			target = strings.Join(append(projects, groups...), ", ")
+		default:
+			target = strings.Join(projects, ", ")
+		}
 		if !yesNo(fmt.Sprintf(
 			`Are you sure you want to export lagoon config for %s on "%s" lagoon?`,
-			project, current)) {
+			target, current)) {
 			return nil // user cancelled
 		}
 
 		viper.SetDefault("lagoons."+current+".version", "1.0.0")
-		lc := client.New(
-			viper.GetString("lagoons."+current+".graphql"),
-			viper.GetString("lagoons."+current+".token"),
-			viper.GetString("lagoons."+current+".version"),
-			debug)
+		lc := newLagoonClient()
 
-		conf, err := lagoon.ExportProject(
-			context.TODO(), lc, project, sliceToMap(exclude))
+		if all || len(groups) > 0 {
+			names, err := lagoon.ProjectNames(cmdContext, lc, all, groups)
+			if err != nil {
+				return err
+			}
+			projects = append(projects, names...)
+		}
+
+		conf, err := lagoon.ExportProjects(
+			cmdContext, lc, projects, sliceToMap(exclude))
 		if err != nil {
 			return err
 		}
+		if redact || secretsFile != "" {
+			var secrets map[string]string
+			conf, secrets, err = lagoon.RedactConfig(conf)
+			if err != nil {
+				return err
+			}
+			if secretsFile != "" {
+				if err = writeSecretsFile(secretsFile, secrets); err != nil {
+					return err
+				}
+			}
+		}
 
 		_, err = fmt.Print(string(conf))
 		return err
@@ -131,12 +392,34 @@ func init() {
 		"on error, just log and continue instead of aborting")
 	importCmd.Flags().Uint("openshiftID", 0,
 		"ID of the openshift to target for import")
-	for _, flag := range []string{"import-file", "openshiftID"} {
-		if err := importCmd.MarkFlagRequired(flag); err != nil {
-			panic(err)
-		}
-	}
+	importCmd.Flags().Bool("dry-run", false,
+		"validate the config and print the mutations instead of sending them")
+	importCmd.Flags().Int("parallel", 1,
+		"maximum number of objects to create concurrently")
+	importCmd.Flags().String("journal", "",
+		"path to write the import journal to "+
+			"(default lagoon-import-<timestamp>.journal)")
+	importCmd.Flags().String("rollback", "",
+		"path to an import journal to roll back instead of importing")
+	importCmd.Flags().Bool("template", false,
+		"render the file as a Go template before importing it")
+	importCmd.Flags().StringSlice("values", nil,
+		"path to a YAML file of template values (implies --template)")
+	importCmd.Flags().StringArray("set", nil,
+		"set a template value as key=value (implies --template)")
+	importCmd.Flags().Bool("render", false,
+		"print the rendered file instead of importing it")
 
+	// this shadows the global --project flag to allow multiple projects
+	exportCmd.Flags().StringSliceP("project", "p", nil,
+		"name of a project to export (may be repeated)")
+	exportCmd.Flags().StringSlice("group", nil,
+		"export all projects in the named group (may be repeated)")
+	exportCmd.Flags().Bool("all", false, "export all projects")
 	exportCmd.Flags().StringSlice("exclude", []string{"project-private-keys"},
 		`Exclude data from the export. Valid options (others are ignored): users, project-users, groups, notifications, project-private-keys`)
+	exportCmd.Flags().Bool("redact-secrets", false,
+		"replace secret values with secret references")
+	exportCmd.Flags().String("secrets-file", "",
+		"path to write the redacted secret values to (implies --redact-secrets)")
 }
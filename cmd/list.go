@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/amazeeio/lagoon-cli/internal/helpers"
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -44,11 +48,10 @@ var listProjectsCmd = &cobra.Command{
 	Aliases: []string{"p"},
 	Short:   "List all projects you have access to (alias: p)",
 	Run: func(cmd *cobra.Command, args []string) {
-		returnedJSON, err := pClient.ListAllProjects()
-		handleError(err)
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		var projects []schema.Project
+		err := newLagoonClient().AllProjectsInfo(cmdContext, &projects)
 		handleError(err)
+		dataMain := projectsTable(projects)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -63,11 +66,10 @@ var listGroupsCmd = &cobra.Command{
 	Aliases: []string{"g"},
 	Short:   "List groups you have access to (alias: g)",
 	Run: func(cmd *cobra.Command, args []string) {
-		returnedJSON, err := uClient.ListGroups("")
-		handleError(err)
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		var groups []schema.Group
+		err := newLagoonClient().AllGroups(cmdContext, "", &groups)
 		handleError(err)
+		dataMain := groupsTable(groups)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -89,17 +91,14 @@ var listGroupProjectsCmd = &cobra.Command{
 				os.Exit(1)
 			}
 		}
-		var returnedJSON []byte
-		var err error
+		name := groupName
 		if listAllProjects {
-			returnedJSON, err = uClient.ListGroupProjects("", listAllProjects)
-		} else {
-			returnedJSON, err = uClient.ListGroupProjects(groupName, listAllProjects)
+			name = ""
 		}
+		var groups []schema.Group
+		err := newLagoonClient().AllGroups(cmdContext, name, &groups)
 		handleError(err)
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
-		handleError(err)
+		dataMain := groupProjectsTable(groups, listAllProjects)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -119,11 +118,9 @@ var listProjectCmd = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		returnedJSON, err := pClient.ListEnvironmentsForProject(cmdProjectName)
-		handleError(err)
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		project, err := lagoon.GetProjectInfo(cmdContext, newLagoonClient(), cmdProjectName)
 		handleError(err)
+		dataMain := environmentsTable(project.Environments)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -144,17 +141,11 @@ var listVariablesCmd = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		var returnedJSON []byte
-		var err error
-		if cmdProjectEnvironment != "" {
-			returnedJSON, err = eClient.ListEnvironmentVariables(cmdProjectName, cmdProjectEnvironment, getListFlags.Reveal)
-		} else {
-			returnedJSON, err = pClient.ListProjectVariables(cmdProjectName, getListFlags.Reveal)
-		}
-		handleError(err)
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		envVars, err := lagoon.GetEnvVariables(cmdContext, newLagoonClient(),
+			cmdProjectName, cmdProjectEnvironment)
 		handleError(err)
+		dataMain := envVariablesTable(envVars, cmdProjectName, cmdProjectEnvironment,
+			getListFlags.Reveal)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -173,12 +164,10 @@ var listDeploymentsCmd = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		returnedJSON, err := eClient.GetEnvironmentDeployments(cmdProjectName, cmdProjectEnvironment)
-		handleError(err)
-
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		deployments, err := lagoon.GetDeployments(cmdContext, newLagoonClient(),
+			cmdProjectName, cmdProjectEnvironment)
 		handleError(err)
+		dataMain := deploymentsTable(deployments)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -197,12 +186,10 @@ var listTasksCmd = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		returnedJSON, err := eClient.GetEnvironmentTasks(cmdProjectName, cmdProjectEnvironment)
-		handleError(err)
-
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		tasks, err := lagoon.GetTasks(cmdContext, newLagoonClient(),
+			cmdProjectName, cmdProjectEnvironment)
 		handleError(err)
+		dataMain := tasksTable(tasks)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -218,12 +205,10 @@ var listUsersCmd = &cobra.Command{
 	Short:   "List all users in groups (alias: u)",
 	Long:    `List all users in groups in lagoon, this only shows users that are in groups.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		returnedJSON, err := uClient.ListUsers(groupName)
-		handleError(err)
-
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		var groups []schema.Group
+		err := newLagoonClient().AllGroups(cmdContext, groupName, &groups)
 		handleError(err)
+		dataMain := usersTable(groups)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -233,6 +218,164 @@ var listUsersCmd = &cobra.Command{
 	},
 }
 
+// projectsTable returns a table of basic info about the given projects.
+func projectsTable(projects []schema.Project) output.Table {
+	data := []output.Data{}
+	for _, project := range projects {
+		// count the current dev environments in a project
+		var currentDevEnvironments = 0
+		for _, environment := range project.Environments {
+			if isEnvType(environment.EnvironmentType, api.DevelopmentEnv) {
+				currentDevEnvironments++
+			}
+		}
+		data = append(data, []string{
+			fmt.Sprintf("%v", project.ID),
+			project.Name,
+			project.GitURL,
+			fmt.Sprintf("%v/%v", currentDevEnvironments, project.DevelopmentEnvironmentsLimit),
+		})
+	}
+	return output.Table{
+		Header: []string{"ID", "ProjectName", "GitURL", "DevEnvironments"},
+		Data:   data,
+	}
+}
+
+// environmentsTable returns a table of basic info about the given
+// environments.
+func environmentsTable(environments []schema.EnvironmentConfig) output.Table {
+	data := []output.Data{}
+	for _, environment := range environments {
+		var envRoute = "none"
+		if environment.Route != "" {
+			envRoute = environment.Route
+		}
+		data = append(data, []string{
+			fmt.Sprintf("%d", environment.ID),
+			environment.Name,
+			string(environment.DeployType),
+			string(environment.EnvironmentType),
+			envRoute,
+		})
+	}
+	return output.Table{
+		Header: []string{"ID", "Name", "DeployType", "Environment", "Route"},
+		Data:   data,
+	}
+}
+
+// envVariablesTable returns a table of the given envVariables of a project,
+// or of an environment if environmentName is not empty. Values are only
+// listed if reveal is true.
+func envVariablesTable(envVars []schema.EnvKeyValue,
+	projectName, environmentName string, reveal bool) output.Table {
+	data := []output.Data{}
+	for _, envVar := range envVars {
+		envVarRow := []string{fmt.Sprintf("%v", envVar.ID), projectName}
+		if environmentName != "" {
+			envVarRow = append(envVarRow, environmentName)
+		}
+		envVarRow = append(envVarRow, string(envVar.Scope), envVar.Name)
+		if reveal {
+			envVarRow = append(envVarRow, envVar.Value)
+		}
+		data = append(data, envVarRow)
+	}
+	dataMain := output.Table{
+		Header: []string{"ID", "Project"},
+		Data:   data,
+	}
+	if environmentName != "" {
+		dataMain.Header = append(dataMain.Header, "Environment")
+	}
+	dataMain.Header = append(dataMain.Header, "Scope", "VariableName")
+	if reveal {
+		dataMain.Header = append(dataMain.Header, "VariableValue")
+	}
+	return dataMain
+}
+
+// deploymentsTable returns a table of the given deployments.
+func deploymentsTable(deployments []schema.Deployment) output.Table {
+	data := []output.Data{}
+	for _, deployment := range deployments {
+		data = append(data, []string{
+			fmt.Sprintf("%v", deployment.ID),
+			helpers.ReturnNonEmptyString(deployment.RemoteID),
+			helpers.ReturnNonEmptyString(noSpaces(deployment.Name)),
+			helpers.ReturnNonEmptyString(string(deployment.Status)),
+			helpers.ReturnNonEmptyString(deployment.Created),
+			helpers.ReturnNonEmptyString(deployment.Started),
+			helpers.ReturnNonEmptyString(deployment.Completed),
+		})
+	}
+	return output.Table{
+		Header: []string{"ID", "RemoteID", "Name", "Status", "Created", "Started", "Completed"},
+		Data:   data,
+	}
+}
+
+// tasksTable returns a table of the given tasks.
+func tasksTable(tasks []schema.Task) output.Table {
+	data := []output.Data{}
+	for _, task := range tasks {
+		data = append(data, []string{
+			fmt.Sprintf("%v", task.ID),
+			helpers.ReturnNonEmptyString(task.RemoteID),
+			helpers.ReturnNonEmptyString(noSpaces(task.Name)),
+			helpers.ReturnNonEmptyString(string(task.Status)),
+			helpers.ReturnNonEmptyString(task.Created),
+			helpers.ReturnNonEmptyString(task.Started),
+			helpers.ReturnNonEmptyString(task.Completed),
+			helpers.ReturnNonEmptyString(task.Service),
+		})
+	}
+	return output.Table{
+		Header: []string{"ID", "RemoteID", "Name", "Status", "Created", "Started", "Completed", "Service"},
+		Data:   data,
+	}
+}
+
+// groupsTable returns a table of the given groups.
+func groupsTable(groups []schema.Group) output.Table {
+	data := []output.Data{}
+	for _, group := range groups {
+		var id string
+		if group.ID != nil {
+			id = group.ID.String()
+		}
+		data = append(data, []string{id, group.Name})
+	}
+	return output.Table{
+		Header: []string{"ID", "Name"},
+		Data:   data,
+	}
+}
+
+// groupProjectsTable returns a table of the projects in the given groups. If
+// withGroup is true the name of the group is listed with each project.
+func groupProjectsTable(groups []schema.Group, withGroup bool) output.Table {
+	data := []output.Data{}
+	for _, group := range groups {
+		for _, project := range group.Projects {
+			projectData := []string{fmt.Sprintf("%v", project.ID), project.Name}
+			if withGroup {
+				projectData = append(projectData, group.Name)
+			}
+			data = append(data, projectData)
+		}
+	}
+	dataMain := output.Table{
+		Header: []string{"ID", "ProjectName"},
+		Data:   data,
+	}
+	if withGroup {
+		dataMain.Header = append(dataMain.Header, "GroupName")
+	}
+	return dataMain
+}
+
 func init() {
 	listCmd.AddCommand(listDeploymentsCmd)
 	listCmd.AddCommand(listGroupsCmd)
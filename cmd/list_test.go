@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/output"
+)
+
+// checkTable compares the JSON encoding of the given table to expect.
+func checkTable(t *testing.T, table output.Table, expect string) {
+	t.Helper()
+	result, err := json.Marshal(table)
+	if err != nil {
+		t.Fatalf("couldn't marshal table: %v", err)
+	}
+	if string(result) != expect {
+		t.Fatalf("got:\n[%v]\nwant:\n[%v]", string(result), expect)
+	}
+}
+
+func TestProjectsTable(t *testing.T) {
+	var allProjects = `[
+	{"developmentEnvironmentsLimit":5,"environments":[],"gitUrl":"ssh://git@192.168.99.1:2222/git/project1.git","id":1,"name":"credentialstest-project1"},
+	{"developmentEnvironmentsLimit":5,"environments":[
+		{"environmentType":"production","route":null}],
+		"gitUrl":"ssh://git@192.168.99.1:2222/git/github.git","id":3,"name":"ci-github"},
+	{"developmentEnvironmentsLimit":5,"environments":[
+		{"environmentType":"production","route":"http://highcotton.org"},
+		{"environmentType":"development","route":"https://varnish-highcotton-org-staging.us.amazee.io"},
+		{"environmentType":"development","route":"https://varnish-highcotton-org-development.us.amazee.io"},
+		{"environmentType":"development","route":""},
+		{"environmentType":"development","route":null}],
+		"gitUrl":"test","id":18,"name":"high-cotton"}
+]`
+	var expect = `{"header":["ID","ProjectName","GitURL","DevEnvironments"],"data":[["1","credentialstest-project1","ssh://git@192.168.99.1:2222/git/project1.git","0/5"],["3","ci-github","ssh://git@192.168.99.1:2222/git/github.git","0/5"],["18","high-cotton","test","4/5"]]}`
+	var projects []schema.Project
+	if err := json.Unmarshal([]byte(allProjects), &projects); err != nil {
+		t.Fatalf("couldn't unmarshal projects: %v", err)
+	}
+	checkTable(t, projectsTable(projects), expect)
+}
+
+func TestEnvironmentsTable(t *testing.T) {
+	var projectInfo = `{"autoIdle":1,"branches":"true","developmentEnvironmentsLimit":5,"environments":[
+	{"deployType":"branch","environmentType":"production","id":3,"name":"Master","openshiftProjectName":"high-cotton-master","route":"http://highcotton.org"},
+	{"deployType":"branch","environmentType":"development","id":4,"name":"Staging","openshiftProjectName":"high-cotton-staging","route":"https://varnish-highcotton-org-staging.us.amazee.io"},
+	{"deployType":"branch","environmentType":"development","id":5,"name":"Development","openshiftProjectName":"high-cotton-development","route":"https://varnish-highcotton-org-development.us.amazee.io"},
+	{"deployType":"pullrequest","environmentType":"development","id":6,"name":"PR-175","openshiftProjectName":"high-cotton-pr-175","route":""},
+	{"deployType":"branch","environmentType":"development","id":10,"name":"high-cotton","openshiftProjectName":"high-cotton-high-cotton","route":null}],
+	"gitUrl":"test","id":18,"name":"high-cotton","productionEnvironment":"Master","pullrequests":"true","storageCalc":1,"subfolder":null
+}`
+	var expect = `{"header":["ID","Name","DeployType","Environment","Route"],"data":[["3","Master","branch","production","http://highcotton.org"],["4","Staging","branch","development","https://varnish-highcotton-org-staging.us.amazee.io"],["5","Development","branch","development","https://varnish-highcotton-org-development.us.amazee.io"],["6","PR-175","pullrequest","development","none"],["10","high-cotton","branch","development","none"]]}`
+	var project schema.Project
+	if err := json.Unmarshal([]byte(projectInfo), &project); err != nil {
+		t.Fatalf("couldn't unmarshal project: %v", err)
+	}
+	checkTable(t, environmentsTable(project.Environments), expect)
+}
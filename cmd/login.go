@@ -2,15 +2,14 @@ package cmd
 
 import (
 	"fmt"
-	"io/ioutil"
-	"net"
 	"os"
-	"strings"
+	"path/filepath"
 
+	"github.com/amazeeio/lagoon-cli/internal/auth"
+	lagoonssh "github.com/amazeeio/lagoon-cli/pkg/lagoon/ssh"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"golang.org/x/crypto/ssh"
-	"golang.org/x/crypto/ssh/agent"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
@@ -24,85 +23,174 @@ var loginCmd = &cobra.Command{
 	},
 }
 
-func publicKey(path string, skipAgent bool) (ssh.AuthMethod, func() error) {
-	noopCloseFunc := func() error { return nil }
-
-	if skipAgent != true {
-		// Connect to SSH agent to ask for unencrypted private keys
-		if sshAgentConn, err := net.Dial("unix", os.Getenv("SSH_AUTH_SOCK")); err == nil {
-			sshAgent := agent.NewClient(sshAgentConn)
+// lagoonSSHAuth returns the ssh.AuthMethod used to authenticate to the SSH
+// service of the given lagoon, and a function to call once authentication has
+// finished. The key given by --ssh-key, or else the ssh-key of the lagoon in
+// the config file, is used on its own. Otherwise the keys in ssh-agent and the
+// default key files are tried in order.
+func lagoonSSHAuth(lagoon string) (ssh.AuthMethod, func() error, error) {
+	keys := auth.SSHKeys{Passphrase: sshKeyPassphrase}
+	sshKey := cmdSSHKey
+	if sshKey == "" {
+		sshKey = viper.GetString("lagoons." + lagoon + ".ssh-key")
+	}
+	if sshKey != "" {
+		keys.Files = []string{sshKey}
+	} else {
+		keys.Files = auth.DefaultKeyFiles(userPath)
+		keys.SkipMissing = true
+		keys.AgentSocket = os.Getenv("SSH_AUTH_SOCK")
+	}
+	return keys.AuthMethod()
+}
 
-			keys, _ := sshAgent.List()
-			if len(keys) > 0 {
-				// There are key(s) in the agent
-				//defer sshAgentConn.Close()
-				return ssh.PublicKeysCallback(sshAgent.Signers), sshAgentConn.Close
-			}
-		}
+// sshKeyPassphrase returns the passphrase of an encrypted SSH key. Like ssh,
+// it runs $SSH_ASKPASS if there is no terminal or SSH_ASKPASS_REQUIRE=force,
+// and prompts for the passphrase otherwise.
+func sshKeyPassphrase(path string) ([]byte, error) {
+	prompt := fmt.Sprintf("Enter passphrase for key '%s': ", path)
+	isTerminal := terminal.IsTerminal(int(os.Stdin.Fd()))
+	if askPass := os.Getenv("SSH_ASKPASS"); askPass != "" &&
+		(!isTerminal || os.Getenv("SSH_ASKPASS_REQUIRE") == "force") {
+		return auth.AskPass(askPass, prompt)
+	}
+	if !isTerminal {
+		return nil, fmt.Errorf("no terminal to read the passphrase from, set SSH_ASKPASS")
 	}
+	fmt.Fprint(os.Stderr, prompt)
+	passphrase, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	return passphrase, err
+}
 
-	key, err := ioutil.ReadFile(path)
-	handleError(err)
+// lagoonHostKeyConfig returns the host key verification settings of the given
+// lagoon in the config file.
+func lagoonHostKeyConfig(lagoon string) lagoonssh.HostKeyConfig {
+	knownHostsFile := viper.GetString("lagoons." + lagoon + ".known-hosts-file")
+	if knownHostsFile == "" {
+		knownHostsFile = filepath.Join(userPath, ".lagoon.known_hosts")
+	}
+	return lagoonssh.HostKeyConfig{
+		Fingerprints:       viper.GetStringSlice("lagoons." + lagoon + ".host-key-fingerprints"),
+		KnownHostsFile:     knownHostsFile,
+		UserKnownHostsFile: filepath.Join(userPath, ".ssh", "known_hosts"),
+	}
+}
 
-	// Try to look for an unencrypted private key
-	signer, err := ssh.ParsePrivateKey(key)
-	if err != nil {
-		fmt.Println("Error was:", err.Error())
-		os.Exit(1)
-	} else if err == nil {
-		// return unencrypted private key
-		return ssh.PublicKeys(signer), noopCloseFunc
+// lagoonAuthMethod returns the auth method configured for the given lagoon.
+func lagoonAuthMethod(lagoon string) string {
+	if method := viper.GetString("lagoons." + lagoon + ".auth"); method != "" {
+		return method
 	}
+	return "ssh"
+}
 
-	// Handle encrypted private keys
-	fmt.Println("Found an encrypted private key!")
-	fmt.Printf("Enter passphrase for '%s': ", path)
-	bytePassword, err := terminal.ReadPassword(int(os.Stdin.Fd()))
-	fmt.Println()
-	signer, err = ssh.ParsePrivateKeyWithPassphrase(key, bytePassword)
-	return ssh.PublicKeys(signer), noopCloseFunc
+// savesToken returns true if the tokens of the given auth method are saved in
+// the config file. Tokens from static sources and credential helpers are
+// fetched again by each command instead.
+func savesToken(method string) bool {
+	return method == "ssh" || method == "keycloak"
 }
 
-func loginToken() error {
-	skipAgent := false
-	privateKey := fmt.Sprintf("%s/.ssh/id_rsa", userPath)
-	if cmdSSHKey != "" {
-		privateKey = cmdSSHKey
-		skipAgent = true
-	}
-	authMethod, closeSSHAgent := publicKey(privateKey, skipAgent)
-	config := &ssh.ClientConfig{
-		User: "lagoon",
-		Auth: []ssh.AuthMethod{
-			authMethod,
-		},
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+// lagoonTokenSource returns the TokenSource configured for the given lagoon
+// in the config file.
+func lagoonTokenSource(lagoon string) (auth.TokenSource, error) {
+	key := "lagoons." + lagoon + "."
+	switch method := lagoonAuthMethod(lagoon); method {
+	case "ssh":
+		authMethod, closeSSHAgent, err := lagoonSSHAuth(lagoon)
+		if err != nil {
+			return nil, err
+		}
+		addr := fmt.Sprintf("%s:%s",
+			viper.GetString(key+"hostname"), viper.GetString(key+"port"))
+		hostKeyConfig := lagoonHostKeyConfig(lagoon)
+		return &auth.SSH{
+			Addr: addr,
+			Config: &ssh.ClientConfig{
+				User:              "lagoon",
+				Auth:              []ssh.AuthMethod{authMethod},
+				HostKeyCallback:   hostKeyConfig.HostKeyCallback(),
+				HostKeyAlgorithms: hostKeyConfig.HostKeyAlgorithms(addr),
+			},
+			Cleanup: closeSSHAgent,
+		}, nil
+	case "keycloak":
+		httpClient, err := lagoonTLSConfig(lagoon).HTTPClient()
+		if err != nil {
+			return nil, fmt.Errorf("invalid TLS config: %w", err)
+		}
+		refreshToken, err := lagoonSecret(lagoon, "refresh-token")
+		if err != nil {
+			return nil, err
+		}
+		clientID := viper.GetString(key + "keycloak-client-id")
+		if clientID == "" {
+			clientID = "lagoon-cli"
+		}
+		return &auth.Keycloak{
+			URL:          viper.GetString(key + "keycloak-url"),
+			ClientID:     clientID,
+			Grant:        viper.GetString(key + "keycloak-grant"),
+			Username:     viper.GetString(key + "keycloak-username"),
+			Password:     keycloakPassword,
+			RefreshToken: refreshToken,
+			HTTPClient:   httpClient,
+			Out:          os.Stderr,
+		}, nil
+	case "static":
+		return &auth.Static{
+			Env:  viper.GetString(key + "token-env"),
+			File: viper.GetString(key + "token-file"),
+		}, nil
+	case "helper":
+		return &auth.Helper{
+			Command: viper.GetString(key + "token-helper"),
+			Lagoon:  lagoon,
+			GraphQL: viper.GetString(key + "graphql"),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown auth method %q for lagoon %s", method, lagoon)
 	}
-	defer closeSSHAgent()
+}
 
-	sshHost := fmt.Sprintf("%s:%s",
-		viper.GetString("lagoons."+cmdLagoon+".hostname"),
-		viper.GetString("lagoons."+cmdLagoon+".port"))
-	conn, err := ssh.Dial("tcp", sshHost, config)
+// keycloakPassword returns the password for the Keycloak password grant from
+// the LAGOON_PASSWORD environment variable, or prompts for it.
+func keycloakPassword() (string, error) {
+	if password := os.Getenv("LAGOON_PASSWORD"); password != "" {
+		return password, nil
+	}
+	fmt.Fprint(os.Stderr, "Enter Keycloak password: ")
+	password, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
 	if err != nil {
-		return fmt.Errorf("couldn't connect to %s: %v", sshHost, err)
+		return "", fmt.Errorf("couldn't read password: %v", err)
 	}
-	defer conn.Close()
+	return string(password), nil
+}
 
-	session, err := conn.NewSession()
+// loginToken gets a new token for the given lagoon from its configured
+// TokenSource.
+func loginToken(lagoon string) error {
+	source, err := lagoonTokenSource(lagoon)
 	if err != nil {
-		return fmt.Errorf("couldn't open session: %v", err)
+		return err
 	}
-
-	out, err := session.CombinedOutput("token")
+	token, err := source.Token(cmdContext)
 	if err != nil {
-		return fmt.Errorf("couldn't get token: %v", err)
+		return err
 	}
-
-	viper.Set("lagoons."+cmdLagoon+".token", strings.TrimSpace(string(out)))
-	if err = viper.WriteConfig(); err != nil {
-		return fmt.Errorf("couldn't write config: %v", err)
+	lagoonTokens[lagoon] = token
+	if !savesToken(lagoonAuthMethod(lagoon)) {
+		return nil
+	}
+	if err = setLagoonSecret(lagoon, "token", token); err != nil {
+		return fmt.Errorf("couldn't save token: %w", err)
+	}
+	if k, ok := source.(*auth.Keycloak); ok {
+		if err = setLagoonSecret(lagoon, "refresh-token", k.RefreshToken); err != nil {
+			return fmt.Errorf("couldn't save refresh token: %w", err)
+		}
 	}
-
 	return nil
 }
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/amazeeio/lagoon-cli/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var logoutAll bool
+
+var logoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Remove the stored tokens of a Lagoon instance",
+	Long: `Remove the stored tokens of the current Lagoon instance, or of all Lagoon
+instances with --all, from their token store. The tokens are not revoked, they
+are only forgotten by the CLI. The next command will log in again.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		lagoons := []string{viper.GetString("current")}
+		if logoutAll {
+			lagoons = configuredLagoons()
+		}
+		for _, lagoon := range lagoons {
+			if err := removeLagoonSecrets(lagoon); err != nil {
+				return fmt.Errorf("couldn't remove tokens of lagoon %s: %w", lagoon, err)
+			}
+		}
+		output.RenderResult(output.Result{
+			Result: "success",
+			ResultData: map[string]interface{}{
+				"lagoons": lagoons,
+			},
+		}, outputOptions)
+		return nil
+	},
+}
+
+// configuredLagoons returns the names of the lagoons in the config file, in
+// order.
+func configuredLagoons() []string {
+	var lagoons []string
+	for lagoon := range viper.GetStringMap("lagoons") {
+		lagoons = append(lagoons, lagoon)
+	}
+	sort.Strings(lagoons)
+	return lagoons
+}
+
+// removeLagoonSecrets removes the secrets of the given lagoon from its token
+// store.
+func removeLagoonSecrets(lagoon string) error {
+	store, err := openLagoonTokenStore(lagoon)
+	if err != nil {
+		return err
+	}
+	for _, key := range secretKeys {
+		if err = store.Delete(lagoon, key); err != nil {
+			return err
+		}
+	}
+	delete(lagoonTokens, lagoon)
+	return nil
+}
+
+func init() {
+	logoutCmd.Flags().BoolVar(&logoutAll, "all", false,
+		"remove the tokens of all configured Lagoon instances")
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var logsDeploymentName string
+var logsRemoteID string
+var logsFollow bool
+var logsPollInterval time.Duration
+
+var logsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Show the logs of deployments",
+	PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		return validateTokenE(viper.GetString("current"))
+	},
+}
+
+var logsDeploymentCmd = &cobra.Command{
+	Use:     "deployment",
+	Aliases: []string{"d"},
+	Short:   "Show the build log of a deployment",
+	Long: `Show the build log of a deployment, by its name in an environment or by its
+remote ID. With --follow, the build log is polled and new lines are printed
+until the deployment is complete, failed, errored or cancelled. The command
+then fails unless the deployment completed.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		lc := newLagoonClient()
+		var deployment *schema.Deployment
+		var err error
+		switch {
+		case logsRemoteID != "":
+			deployment, err = lagoon.GetDeploymentByRemoteID(cmdContext, lc, logsRemoteID)
+		case logsDeploymentName != "":
+			if cmdProjectName == "" || cmdProjectEnvironment == "" {
+				return errors.New("Missing arguments: Project name or environment name is not defined")
+			}
+			deployment, err = lagoon.GetDeploymentByName(cmdContext, lc,
+				cmdProjectName, cmdProjectEnvironment, logsDeploymentName)
+		default:
+			return errors.New("Missing arguments: Deployment name or remote ID is not defined")
+		}
+		if err != nil {
+			return err
+		}
+		if !logsFollow {
+			if deployment.BuildLog == "" {
+				fmt.Println("Log data is not available")
+				return nil
+			}
+			fmt.Println(deployment.BuildLog)
+			return nil
+		}
+		deployment, err = lagoon.FollowDeploymentLog(cmdContext, lc, deployment,
+			logsPollInterval, os.Stdout)
+		if err != nil {
+			return err
+		}
+		return deploymentResult(deployment)
+	},
+}
+
+// errDeploymentFailed is returned by commands which waited for a deployment
+// which failed, errored or was cancelled.
+var errDeploymentFailed = errors.New("deployment failed")
+
+// deploymentResult returns an error wrapping errDeploymentFailed if a finished
+// deployment didn't complete.
+func deploymentResult(deployment *schema.Deployment) error {
+	if deployment.Status != "" && !isDeployStatus(deployment.Status, api.CompleteDeploy) {
+		return fmt.Errorf("%w: %s finished with status %s", errDeploymentFailed,
+			deployment.Name, deployment.Status)
+	}
+	return nil
+}
+
+func init() {
+	logsCmd.AddCommand(logsDeploymentCmd)
+	logsDeploymentCmd.Flags().StringVarP(&logsDeploymentName, "name", "N", "", "The name of the deployment, such as lagoon-build-abc123")
+	logsDeploymentCmd.Flags().StringVarP(&logsRemoteID, "remoteid", "R", "", "The remote ID of the deployment")
+	logsDeploymentCmd.Flags().BoolVarP(&logsFollow, "follow", "f", false, "Print new lines of the build log until the deployment has finished")
+	logsDeploymentCmd.Flags().DurationVarP(&logsPollInterval, "poll-interval", "", 5*time.Second, "How often to poll the build log with --follow")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/amazeeio/lagoon-cli/internal/schema"
 	"github.com/amazeeio/lagoon-cli/pkg/api"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
@@ -15,11 +16,12 @@ var listRocketChatsCmd = &cobra.Command{
 	Aliases: []string{"r"},
 	Short:   "List Rocketchat details about a project (alias: r)",
 	Run: func(cmd *cobra.Command, args []string) {
-		var returnedJSON []byte
-		var err error
+		var dataMain output.Table
 		if listAllProjects {
-			returnedJSON, err = pClient.ListAllRocketChats()
+			var projects []schema.ProjectRocketChats
+			err := newLagoonClient().AllNotificationsRocketChat(cmdContext, &projects)
 			handleError(err)
+			dataMain = allRocketChatsTable(projects)
 		} else {
 			notificationFlags := parseNotificationFlags(*cmd.Flags())
 			if notificationFlags.Project == "" {
@@ -27,12 +29,12 @@ var listRocketChatsCmd = &cobra.Command{
 				cmd.Help()
 				os.Exit(1)
 			}
-			returnedJSON, err = pClient.ListProjectRocketChats(notificationFlags.Project)
+			project := schema.ProjectRocketChats{}
+			err := newLagoonClient().NotificationsRocketChatByProject(cmdContext,
+				notificationFlags.Project, &project)
 			handleError(err)
+			dataMain = projectRocketChatsTable(project)
 		}
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
-		handleError(err)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -55,14 +57,16 @@ It does not configure a project to send notifications to rocketchat though, you
 			cmd.Help()
 			os.Exit(1)
 		}
-		addResult, err := pClient.AddRocketChatNotification(notificationFlags.NotificationName, notificationFlags.NotificationChannel, notificationFlags.NotificationWebhook)
-		handleError(err)
-		var resultMap map[string]interface{}
-		err = json.Unmarshal([]byte(addResult), &resultMap)
+		rocketchat := schema.NotificationRocketChat{}
+		err := newLagoonClient().AddNotificationRocketChat(cmdContext, &schema.AddNotificationRocketChatInput{
+			Name:    notificationFlags.NotificationName,
+			Webhook: notificationFlags.NotificationWebhook,
+			Channel: notificationFlags.NotificationChannel,
+		}, &rocketchat)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: resultMap,
+			ResultData: map[string]interface{}{"id": rocketchat.ID, "name": rocketchat.Name},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
@@ -81,14 +85,16 @@ This command is used to add an existing rocketchat notification in lagoon to a p
 			cmd.Help()
 			os.Exit(1)
 		}
-		addResult, err := pClient.AddRocketChatNotificationToProject(notificationFlags.Project, notificationFlags.NotificationName)
-		handleError(err)
-		var resultMap map[string]interface{}
-		err = json.Unmarshal([]byte(addResult), &resultMap)
+		project := schema.Project{}
+		err := newLagoonClient().AddNotificationToProject(cmdContext, &schema.AddNotificationToProjectInput{
+			Project:          notificationFlags.Project,
+			NotificationType: api.RocketChatNotification,
+			NotificationName: notificationFlags.NotificationName,
+		}, &project)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: resultMap,
+			ResultData: map[string]interface{}{"id": project.ID, "name": project.Name},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
@@ -106,10 +112,12 @@ var deleteProjectRocketChatNotificationCmd = &cobra.Command{
 			os.Exit(1)
 		}
 		if yesNo(fmt.Sprintf("You are attempting to delete notification '%s' from project '%s', are you sure?", notificationFlags.NotificationName, notificationFlags.Project)) {
-			deleteResult, err := pClient.DeleteRocketChatNotificationFromProject(notificationFlags.Project, notificationFlags.NotificationName)
-			handleError(err)
-			var addedProject api.NotificationSlack
-			err = json.Unmarshal([]byte(deleteResult), &addedProject)
+			project := schema.Project{}
+			err := newLagoonClient().RemoveNotificationFromProject(cmdContext, &schema.RemoveNotificationFromProjectInput{
+				Project:          notificationFlags.Project,
+				NotificationType: api.RocketChatNotification,
+				NotificationName: notificationFlags.NotificationName,
+			}, &project)
 			handleError(err)
 			resultData := output.Result{
 				Result: "success",
@@ -130,10 +138,13 @@ var deleteRocketChatNotificationCmd = &cobra.Command{
 			os.Exit(1)
 		}
 		if yesNo(fmt.Sprintf("You are attempting to delete notification '%s' from lagoon, are you sure?", notificationFlags.NotificationName)) {
-			deleteResult, err := pClient.DeleteRocketChatNotification(notificationFlags.NotificationName)
+			var result string
+			err := newLagoonClient().DeleteNotificationRocketChat(cmdContext, &schema.DeleteNotificationInput{
+				Name: notificationFlags.NotificationName,
+			}, &result)
 			handleError(err)
 			resultData := output.Result{
-				Result: string(deleteResult),
+				Result: result,
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -163,19 +174,60 @@ var updateRocketChatNotificationCmd = &cobra.Command{
 			handleError(err)
 			jsonPatch = string(jsonPatchBytes)
 		}
-		updateResult, err := pClient.UpdateRocketChatNotification(notificationFlags.NotificationOldName, jsonPatch)
+		var patch schema.UpdateNotificationRocketChatPatchInput
+		err := json.Unmarshal([]byte(jsonPatch), &patch)
 		handleError(err)
-		var resultMap map[string]interface{}
-		err = json.Unmarshal([]byte(updateResult), &resultMap)
+		rocketchat := schema.NotificationRocketChat{}
+		err = newLagoonClient().UpdateNotificationRocketChat(cmdContext, &schema.UpdateNotificationRocketChatInput{
+			Name:  notificationFlags.NotificationOldName,
+			Patch: patch,
+		}, &rocketchat)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: resultMap,
+			ResultData: map[string]interface{}{"id": rocketchat.ID, "name": rocketchat.Name},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
 }
 
+// projectRocketChatsTable returns a table of the rocketchat notifications of a project.
+func projectRocketChatsTable(project schema.ProjectRocketChats) output.Table {
+	data := []output.Data{}
+	for _, rocketchat := range project.RocketChats {
+		data = append(data, []string{
+			fmt.Sprintf("%d", rocketchat.ID),
+			rocketchat.Name,
+			rocketchat.Channel,
+			rocketchat.Webhook,
+		})
+	}
+	return output.Table{
+		Header: []string{"NID", "NotificationName", "Channel", "Webhook"},
+		Data:   data,
+	}
+}
+
+// allRocketChatsTable returns a table of the rocketchat notifications of all projects.
+func allRocketChatsTable(projects []schema.ProjectRocketChats) output.Table {
+	data := []output.Data{}
+	for _, project := range projects {
+		for _, rocketchat := range project.RocketChats {
+			data = append(data, []string{
+				fmt.Sprintf("%d", rocketchat.ID),
+				project.Name,
+				rocketchat.Name,
+				rocketchat.Channel,
+				rocketchat.Webhook,
+			})
+		}
+	}
+	return output.Table{
+		Header: []string{"NID", "Project", "NotificationName", "Channel", "Webhook"},
+		Data:   data,
+	}
+}
+
 func init() {
 	addRocketChatNotificationCmd.Flags().StringVarP(&notificationName, "name", "n", "", "The name of the notification")
 	addRocketChatNotificationCmd.Flags().StringVarP(&notificationWebhook, "webhook", "w", "", "The webhook URL of the notification")
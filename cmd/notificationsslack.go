@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/amazeeio/lagoon-cli/internal/schema"
 	"github.com/amazeeio/lagoon-cli/pkg/api"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
@@ -15,11 +16,12 @@ var listSlackCmd = &cobra.Command{
 	Aliases: []string{"s"},
 	Short:   "List Slack details about a project (alias: s)",
 	Run: func(cmd *cobra.Command, args []string) {
-		var returnedJSON []byte
-		var err error
+		var dataMain output.Table
 		if listAllProjects {
-			returnedJSON, err = pClient.ListAllSlacks()
+			var projects []schema.ProjectSlacks
+			err := newLagoonClient().AllNotificationsSlack(cmdContext, &projects)
 			handleError(err)
+			dataMain = allSlacksTable(projects)
 		} else {
 			notificationFlags := parseNotificationFlags(*cmd.Flags())
 			if notificationFlags.Project == "" {
@@ -28,12 +30,12 @@ var listSlackCmd = &cobra.Command{
 				os.Exit(1)
 			}
 
-			returnedJSON, err = pClient.ListProjectSlacks(notificationFlags.Project)
+			project := schema.ProjectSlacks{}
+			err := newLagoonClient().NotificationsSlackByProject(cmdContext,
+				notificationFlags.Project, &project)
 			handleError(err)
+			dataMain = projectSlacksTable(project)
 		}
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
-		handleError(err)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -57,14 +59,16 @@ It does not configure a project to send notifications to slack though, you need
 			cmd.Help()
 			os.Exit(1)
 		}
-		addResult, err := pClient.AddSlackNotification(notificationFlags.NotificationName, notificationFlags.NotificationChannel, notificationFlags.NotificationWebhook)
-		handleError(err)
-		var resultMap map[string]interface{}
-		err = json.Unmarshal([]byte(addResult), &resultMap)
+		slack := schema.NotificationSlack{}
+		err := newLagoonClient().AddNotificationSlack(cmdContext, &schema.AddNotificationSlackInput{
+			Name:    notificationFlags.NotificationName,
+			Webhook: notificationFlags.NotificationWebhook,
+			Channel: notificationFlags.NotificationChannel,
+		}, &slack)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: resultMap,
+			ResultData: map[string]interface{}{"id": slack.ID, "name": slack.Name},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
@@ -83,14 +87,16 @@ This command is used to add an existing slack notification in lagoon to a projec
 			cmd.Help()
 			os.Exit(1)
 		}
-		addResult, err := pClient.AddSlackNotificationToProject(notificationFlags.Project, notificationFlags.NotificationName)
-		handleError(err)
-		var resultMap map[string]interface{}
-		err = json.Unmarshal([]byte(addResult), &resultMap)
+		project := schema.Project{}
+		err := newLagoonClient().AddNotificationToProject(cmdContext, &schema.AddNotificationToProjectInput{
+			Project:          notificationFlags.Project,
+			NotificationType: api.SlackNotification,
+			NotificationName: notificationFlags.NotificationName,
+		}, &project)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: resultMap,
+			ResultData: map[string]interface{}{"id": project.ID, "name": project.Name},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
@@ -108,10 +114,12 @@ var deleteProjectSlackNotificationCmd = &cobra.Command{
 			os.Exit(1)
 		}
 		if yesNo(fmt.Sprintf("You are attempting to delete notification '%s' from project '%s', are you sure?", notificationFlags.NotificationName, notificationFlags.Project)) {
-			deleteResult, err := pClient.DeleteSlackNotificationFromProject(notificationFlags.Project, notificationFlags.NotificationName)
-			handleError(err)
-			var addedProject api.NotificationSlack
-			err = json.Unmarshal([]byte(deleteResult), &addedProject)
+			project := schema.Project{}
+			err := newLagoonClient().RemoveNotificationFromProject(cmdContext, &schema.RemoveNotificationFromProjectInput{
+				Project:          notificationFlags.Project,
+				NotificationType: api.SlackNotification,
+				NotificationName: notificationFlags.NotificationName,
+			}, &project)
 			handleError(err)
 			resultData := output.Result{
 				Result: "success",
@@ -135,10 +143,13 @@ var deleteSlackNotificationCmd = &cobra.Command{
 		fmt.Println(fmt.Sprintf("Deleting notification %s", notificationFlags.NotificationName))
 
 		if yesNo(fmt.Sprintf("You are attempting to delete notification '%s' from lagoon, are you sure?", notificationFlags.NotificationName)) {
-			deleteResult, err := pClient.DeleteSlackNotification(notificationFlags.NotificationName)
+			var result string
+			err := newLagoonClient().DeleteNotificationSlack(cmdContext, &schema.DeleteNotificationInput{
+				Name: notificationFlags.NotificationName,
+			}, &result)
 			handleError(err)
 			resultData := output.Result{
-				Result: string(deleteResult),
+				Result: result,
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -168,19 +179,60 @@ var updateSlackNotificationCmd = &cobra.Command{
 			handleError(err)
 			jsonPatch = string(jsonPatchBytes)
 		}
-		updateResult, err := pClient.UpdateSlackNotification(notificationFlags.NotificationOldName, jsonPatch)
+		var patch schema.UpdateNotificationSlackPatchInput
+		err := json.Unmarshal([]byte(jsonPatch), &patch)
 		handleError(err)
-		var resultMap map[string]interface{}
-		err = json.Unmarshal([]byte(updateResult), &resultMap)
+		slack := schema.NotificationSlack{}
+		err = newLagoonClient().UpdateNotificationSlack(cmdContext, &schema.UpdateNotificationSlackInput{
+			Name:  notificationFlags.NotificationOldName,
+			Patch: patch,
+		}, &slack)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: resultMap,
+			ResultData: map[string]interface{}{"id": slack.ID, "name": slack.Name},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
 }
 
+// projectSlacksTable returns a table of the slack notifications of a project.
+func projectSlacksTable(project schema.ProjectSlacks) output.Table {
+	data := []output.Data{}
+	for _, slack := range project.Slacks {
+		data = append(data, []string{
+			fmt.Sprintf("%d", slack.ID),
+			slack.Name,
+			slack.Channel,
+			slack.Webhook,
+		})
+	}
+	return output.Table{
+		Header: []string{"NID", "NotificationName", "Channel", "Webhook"},
+		Data:   data,
+	}
+}
+
+// allSlacksTable returns a table of the slack notifications of all projects.
+func allSlacksTable(projects []schema.ProjectSlacks) output.Table {
+	data := []output.Data{}
+	for _, project := range projects {
+		for _, slack := range project.Slacks {
+			data = append(data, []string{
+				fmt.Sprintf("%d", slack.ID),
+				project.Name,
+				slack.Name,
+				slack.Channel,
+				slack.Webhook,
+			})
+		}
+	}
+	return output.Table{
+		Header: []string{"NID", "Project", "NotificationName", "Channel", "Webhook"},
+		Data:   data,
+	}
+}
+
 func init() {
 	addSlackNotificationCmd.Flags().StringVarP(&notificationName, "name", "n", "", "The name of the notification")
 	addSlackNotificationCmd.Flags().StringVarP(&notificationWebhook, "webhook", "w", "", "The webhook URL of the notification")
@@ -5,20 +5,23 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/amazeeio/lagoon-cli/pkg/api"
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
-var projectPatch api.ProjectPatch
+var projectPatch schema.UpdateProjectPatchInput
 
 var projectAutoIdle int
 var projectStorageCalc int
 var projectDevelopmentEnvironmentsLimit int
 var projectOpenshift int
 
-func parseProjectFlags(flags pflag.FlagSet) api.ProjectPatch {
+// parseProjectFlags unmarshals the values of the changed project flags into
+// the given project input or patch.
+func parseProjectFlags(flags pflag.FlagSet, v interface{}) {
 	configMap := make(map[string]interface{})
 	flags.VisitAll(func(f *pflag.Flag) {
 		if flags.Changed(f.Name) {
@@ -26,9 +29,7 @@ func parseProjectFlags(flags pflag.FlagSet) api.ProjectPatch {
 		}
 	})
 	jsonStr, _ := json.Marshal(configMap)
-	parsedFlags := api.ProjectPatch{}
-	json.Unmarshal(jsonStr, &parsedFlags)
-	return parsedFlags
+	json.Unmarshal(jsonStr, v)
 }
 
 var deleteProjectCmd = &cobra.Command{
@@ -42,10 +43,12 @@ var deleteProjectCmd = &cobra.Command{
 			os.Exit(1)
 		}
 		if yesNo(fmt.Sprintf("You are attempting to delete project '%s', are you sure?", cmdProjectName)) {
-			deleteResult, err := pClient.DeleteProject(cmdProjectName)
+			var result string
+			err := newLagoonClient().DeleteProject(cmdContext,
+				&schema.DeleteProjectInput{Project: cmdProjectName}, &result)
 			handleError(err)
 			resultData := output.Result{
-				Result: string(deleteResult),
+				Result: result,
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -57,32 +60,27 @@ var addProjectCmd = &cobra.Command{
 	Aliases: []string{"p"},
 	Short:   "Add a new project to lagoon",
 	Run: func(cmd *cobra.Command, args []string) {
-		projectFlags := parseProjectFlags(*cmd.Flags())
+		// autoIdle and storageCalc default to enabled, as they do in Lagoon
+		projectInput := schema.AddProjectInput{AutoIdle: 1, StorageCalc: 1}
+		parseProjectFlags(*cmd.Flags(), &projectInput)
 		if cmdProjectName == "" {
 			fmt.Println("Missing arguments: Project name is not defined")
 			cmd.Help()
 			os.Exit(1)
 		}
+		projectInput.Name = cmdProjectName
 
-		jsonPatch, _ := json.Marshal(projectFlags)
-		addResult, err := pClient.AddProject(cmdProjectName, string(jsonPatch))
+		addedProject := schema.Project{}
+		err := newLagoonClient().AddProject(cmdContext, &projectInput, &addedProject)
 		handleError(err)
-		var addedProject api.Project
-		err = json.Unmarshal([]byte(addResult), &addedProject)
-		handleError(err)
-
-		if err != nil {
-			output.RenderError(err.Error(), outputOptions)
-		} else {
-			resultData := output.Result{
-				Result: "success",
-				ResultData: map[string]interface{}{
-					"Project Name": addedProject.Name,
-					"GitURL":       addedProject.GitURL,
-				},
-			}
-			output.RenderResult(resultData, outputOptions)
+		resultData := output.Result{
+			Result: "success",
+			ResultData: map[string]interface{}{
+				"Project Name": addedProject.Name,
+				"GitURL":       projectInput.GitURL,
+			},
 		}
+		output.RenderResult(resultData, outputOptions)
 	},
 }
 
@@ -91,18 +89,22 @@ var updateProjectCmd = &cobra.Command{
 	Aliases: []string{"p"},
 	Short:   "Update a project",
 	Run: func(cmd *cobra.Command, args []string) {
-		projectFlags := parseProjectFlags(*cmd.Flags())
+		patch := schema.UpdateProjectPatchInput{}
+		parseProjectFlags(*cmd.Flags(), &patch)
 		if cmdProjectName == "" {
 			fmt.Println("Missing arguments: Project name is not defined")
 			cmd.Help()
 			os.Exit(1)
 		}
 
-		jsonPatch, _ := json.Marshal(projectFlags)
-		projectUpdateID, err := pClient.UpdateProject(cmdProjectName, string(jsonPatch))
+		lc := newLagoonClient()
+		project, err := lagoon.GetProjectInfo(cmdContext, lc, cmdProjectName)
 		handleError(err)
-		var updatedProject api.Project
-		err = json.Unmarshal([]byte(projectUpdateID), &updatedProject)
+		updatedProject := schema.Project{}
+		err = lc.UpdateProject(cmdContext, &schema.UpdateProjectInput{
+			ID:    project.ID,
+			Patch: patch,
+		}, &updatedProject)
 		handleError(err)
 		resultData := output.Result{
 			Result: "success",
@@ -129,7 +131,7 @@ func init() {
 	updateProjectCmd.Flags().StringVarP(&projectPatch.ActiveSystemsPromote, "activeSystemsPromote", "P", "", "Which internal Lagoon System is responsible for promoting")
 	updateProjectCmd.Flags().StringVarP(&projectPatch.Branches, "branches", "b", "", "Which branches should be deployed")
 	updateProjectCmd.Flags().StringVarP(&projectPatch.Name, "name", "N", "", "Change the name of the project by specifying a new name (careful!)")
-	updateProjectCmd.Flags().StringVarP(&projectPatch.Pullrequests, "pullrequests", "m", "", "Which Pull Requests should be deployed")
+	updateProjectCmd.Flags().StringVarP(&projectPatch.PullRequests, "pullrequests", "m", "", "Which Pull Requests should be deployed")
 	updateProjectCmd.Flags().StringVarP(&projectPatch.ProductionEnvironment, "productionEnvironment", "E", "", "Which environment(the name) should be marked as the production environment")
 	updateProjectCmd.Flags().StringVarP(&projectPatch.OpenshiftProjectPattern, "openshiftProjectPattern", "o", "", "Pattern of OpenShift Project/Namespace that should be generated")
 
@@ -148,7 +150,7 @@ func init() {
 	addProjectCmd.Flags().StringVarP(&projectPatch.ActiveSystemsRemove, "activeSystemsRemove", "R", "", "Which internal Lagoon System is responsible for promoting")
 	addProjectCmd.Flags().StringVarP(&projectPatch.ActiveSystemsPromote, "activeSystemsPromote", "P", "", "Which internal Lagoon System is responsible for promoting")
 	addProjectCmd.Flags().StringVarP(&projectPatch.Branches, "branches", "b", "", "Which branches should be deployed")
-	addProjectCmd.Flags().StringVarP(&projectPatch.Pullrequests, "pullrequests", "m", "", "Which Pull Requests should be deployed")
+	addProjectCmd.Flags().StringVarP(&projectPatch.PullRequests, "pullrequests", "m", "", "Which Pull Requests should be deployed")
 	addProjectCmd.Flags().StringVarP(&projectPatch.ProductionEnvironment, "productionEnvironment", "E", "", "Which environment(the name) should be marked as the production environment")
 	addProjectCmd.Flags().StringVarP(&projectPatch.OpenshiftProjectPattern, "openshiftProjectPattern", "o", "", "Pattern of OpenShift Project/Namespace that should be generated")
 
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os"
@@ -10,11 +12,10 @@ import (
 	"time"
 
 	"github.com/amazeeio/lagoon-cli/internal/helpers"
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/lagoon/client"
 	"github.com/amazeeio/lagoon-cli/pkg/app"
 	"github.com/amazeeio/lagoon-cli/pkg/graphql"
-	"github.com/amazeeio/lagoon-cli/pkg/lagoon/environments"
-	"github.com/amazeeio/lagoon-cli/pkg/lagoon/projects"
-	"github.com/amazeeio/lagoon-cli/pkg/lagoon/users"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/amazeeio/lagoon-cli/pkg/updatecheck"
 	"github.com/manifoldco/promptui"
@@ -97,13 +98,70 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+// exitCodeError is returned by commands which need to exit with a specific
+// non-zero code without reporting an error.
+type exitCodeError int
+
+func (e exitCodeError) Error() string {
+	return fmt.Sprintf("exit code %d", e)
+}
+
+// The exit codes of commands which fail with one of the lagoon package error
+// types, so that scripts can tell these failures apart.
+const (
+	unauthorizedExitCode = 3
+	notFoundExitCode     = 4
+	existExitCode        = 5
+	forbiddenExitCode    = 6
+	deploymentExitCode   = 7
+	taskExitCode         = 8
+)
+
+// errorExitCode returns the exit code of a command which failed with err.
+func errorExitCode(err error) int {
+	switch {
+	case errors.Is(err, errOperationCancelled):
+		return interruptExitCode
+	case errors.Is(err, lagoon.ErrUnauthorized):
+		return unauthorizedExitCode
+	case errors.Is(err, lagoon.ErrNotFound):
+		return notFoundExitCode
+	case errors.Is(err, lagoon.ErrExist):
+		return existExitCode
+	case errors.Is(err, lagoon.ErrForbidden):
+		return forbiddenExitCode
+	case errors.Is(err, errDeploymentFailed):
+		return deploymentExitCode
+	case errors.Is(err, errTaskFailed):
+		return taskExitCode
+	}
+	return 1
+}
+
+// exitWithError renders err and exits with the matching exit code.
+func exitWithError(ctx context.Context, err error) {
+	err = contextError(ctx, err)
+	output.RenderError(err.Error(), outputOptions)
+	os.Exit(errorExitCode(err))
+}
+
 // Execute the root command.
 func Execute() {
 	viper.AutomaticEnv()
-	if err := rootCmd.Execute(); err != nil {
-		output.RenderError(err.Error(), outputOptions)
-		os.Exit(1)
+	ctx, stop := notifyInterrupt(context.Background())
+	cmdContext = ctx
+	err := rootCmd.Execute()
+	if err != nil {
+		var exitCode exitCodeError
+		if errors.As(err, &exitCode) {
+			os.Exit(int(exitCode))
+		}
+		// the context must still be live here, or every error would be
+		// reported as a cancellation
+		exitWithError(cmdContext, err)
 	}
+	cancelTimeout()
+	stop()
 }
 
 //IsInternetActive() checks to see if we have a viable
@@ -115,7 +173,7 @@ func isInternetActive() bool {
 }
 
 func init() {
-	cobra.OnInitialize(initConfig)
+	cobra.OnInitialize(initConfig, initContext)
 
 	rootCmd.PersistentFlags().StringVarP(&cmdProjectName, "project", "p", "", "Specify a project to use")
 	rootCmd.PersistentFlags().StringVarP(&cmdProjectEnvironment, "environment", "e", "", "Specify an environment to use")
@@ -131,6 +189,8 @@ func init() {
 	rootCmd.PersistentFlags().BoolVarP(&outputOptions.Pretty, "pretty", "", false, "Make JSON pretty (if supported)")
 	rootCmd.PersistentFlags().BoolVarP(&debugEnable, "debug", "", false, "Enable debugging output (if supported)")
 	rootCmd.PersistentFlags().BoolVarP(&skipUpdateCheck, "skip-update-check", "", false, "Skip checking for updates")
+	rootCmd.PersistentFlags().IntVarP(&cmdRetries, "retries", "", client.DefaultRetryPolicy.MaxRetries, "Number of times to retry API requests which fail with a network or server error")
+	rootCmd.PersistentFlags().DurationVarP(&cmdTimeout, "timeout", "", 0, "Cancel the command if it takes longer than this (e.g. 30s, 5m; 0 means no timeout)")
 
 	// get config-file from flag
 	rootCmd.PersistentFlags().StringP("config-file", "", "", "Path to the config file to use (must be *.yml or *.yaml)")
@@ -169,19 +229,28 @@ Additional help topics:{{range .Commands}}{{if .IsAdditionalHelpTopicCommand}}
 Use "{{.CommandPath}} [command] --help" for more information about a command.{{end}}
 `)
 	rootCmd.AddCommand(addCmd)
+	rootCmd.AddCommand(authCmd)
+	rootCmd.AddCommand(cancelCmd)
+	rootCmd.AddCommand(completionCmd)
+	rootCmd.AddCommand(completeCmd)
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(deleteCmd)
 	rootCmd.AddCommand(deployCmd)
+	rootCmd.AddCommand(downloadCmd)
 	rootCmd.AddCommand(getCmd)
 	rootCmd.AddCommand(kibanaCmd)
 	rootCmd.AddCommand(listCmd)
 	rootCmd.AddCommand(loginCmd)
+	rootCmd.AddCommand(logsCmd)
+	rootCmd.AddCommand(logoutCmd)
 	rootCmd.AddCommand(runCmd)
 	rootCmd.AddCommand(sshEnvCmd)
 	rootCmd.AddCommand(updateCmd)
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(webCmd)
 	rootCmd.AddCommand(importCmd)
+	rootCmd.AddCommand(applyCmd)
+	rootCmd.AddCommand(diffCmd)
 	rootCmd.AddCommand(exportCmd)
 	rootCmd.AddCommand(whoamiCmd)
 }
@@ -327,11 +396,6 @@ func unset(key string) error {
 	return nil
 }
 
-// global the clients
-var eClient environments.Client
-var uClient users.Client
-var pClient projects.Client
-
 // FormatType .
 type FormatType string
 
@@ -343,59 +407,44 @@ const (
 )
 
 func validateToken(lagoon string) {
-	valid := graphql.VerifyTokenExpiry(lagoon)
-	if valid == false {
-		loginErr := loginToken()
+	if valid, err := hasValidToken(lagoon); err != nil {
+		fmt.Println("Unable to read token, error was", err.Error())
+		os.Exit(1)
+	} else if valid == false {
+		loginErr := loginToken(lagoon)
 		if loginErr != nil {
 			fmt.Println("Unable to refresh token, you may need to run `lagoon login` first, error was", loginErr.Error())
 			os.Exit(1)
 		}
 	}
-	// set up the clients
-	var err error
-	eClient, err = environments.New(debugEnable)
-	if err != nil {
-		output.RenderError(err.Error(), outputOptions)
-		os.Exit(1)
-	}
-	uClient, err = users.New(debugEnable)
-	if err != nil {
-		output.RenderError(err.Error(), outputOptions)
-		os.Exit(1)
+	outputOptions.Debug = debugEnable
+}
+
+// hasValidToken returns true if the stored token of the given lagoon hasn't
+// expired. Tokens which aren't stored are always fetched again.
+func hasValidToken(lagoon string) (bool, error) {
+	if !savesToken(lagoonAuthMethod(lagoon)) {
+		return false, nil
 	}
-	pClient, err = projects.New(debugEnable)
+	token, err := readLagoonToken(lagoon)
 	if err != nil {
-		output.RenderError(err.Error(), outputOptions)
-		os.Exit(1)
+		return false, err
 	}
-	outputOptions.Debug = debugEnable
+	return graphql.VerifyToken(token), nil
 }
 
 // validateTokenE does the same thing as validateToken, it just returns an
 // error instead of exiting on error.
 func validateTokenE(lagoon string) error {
-	var err error
-	if graphql.VerifyTokenExpiry(lagoon) {
-		return nil // nothing to do
-	}
-	if err = loginToken(); err != nil {
-		return fmt.Errorf("Couldn't refresh token, try `lagoon login`: %w", err)
-	}
-	// set up the clients
-	eClient, err = environments.New(debugEnable)
+	valid, err := hasValidToken(lagoon)
 	if err != nil {
-		output.RenderError(err.Error(), outputOptions)
-		return err
+		return fmt.Errorf("Couldn't read token: %w", err)
 	}
-	uClient, err = users.New(debugEnable)
-	if err != nil {
-		output.RenderError(err.Error(), outputOptions)
-		return err
+	if valid {
+		return nil // nothing to do
 	}
-	pClient, err = projects.New(debugEnable)
-	if err != nil {
-		output.RenderError(err.Error(), outputOptions)
-		return err
+	if err = loginToken(lagoon); err != nil {
+		return fmt.Errorf("Couldn't refresh token, try `lagoon login`: %w", err)
 	}
 	outputOptions.Debug = debugEnable
 	return nil
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -19,4 +21,6 @@ func init() {
 	runCmd.AddCommand(runDrushArchiveDump)
 	runCmd.AddCommand(runDrushCacheClear)
 	runCmd.AddCommand(runDrushSQLDump)
+	runCmd.PersistentFlags().BoolVarP(&taskWait, "wait", "", false, "Wait for the task to finish, and fail if it didn't succeed")
+	runCmd.PersistentFlags().DurationVarP(&taskPollInterval, "poll-interval", "", 5*time.Second, "How often to check the status of the task with --wait")
 }
@@ -1,9 +1,14 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
+	"github.com/amazeeio/lagoon-cli/internal/lagoon/client"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
+	"github.com/spf13/viper"
 )
 
 // config vars
@@ -13,6 +18,21 @@ var lagoonGraphQL string
 var lagoonToken string
 var lagoonUI string
 var lagoonKibana string
+var lagoonCAFile string
+var lagoonClientCert string
+var lagoonClientKey string
+var lagoonInsecureSkipVerify bool
+var lagoonHostKeyFingerprints []string
+var lagoonKnownHostsFile string
+var lagoonAuth string
+var lagoonKeycloakURL string
+var lagoonKeycloakClientID string
+var lagoonKeycloakGrant string
+var lagoonKeycloakUsername string
+var lagoonTokenEnv string
+var lagoonTokenFile string
+var lagoonTokenHelper string
+var lagoonTokenStore string
 
 // variable vars
 var variableValue string
@@ -59,11 +79,86 @@ var outputOptions = output.Options{
 
 var debugEnable bool
 
+var cmdRetries int
+
 var noDataError = "no data returned from the lagoon api"
 
 func handleError(err error) {
 	if err != nil {
-		output.RenderError(err.Error(), outputOptions)
-		os.Exit(1)
+		exitWithError(cmdContext, err)
+	}
+}
+
+// newLagoonClient returns a client for the API of the current lagoon, which
+// retries transient failures up to --retries times.
+func newLagoonClient() *client.Client {
+	return newLagoonClientFor(viper.GetString("current"))
+}
+
+// newLagoonClientFor returns a client for the API of the given lagoon.
+func newLagoonClientFor(lagoon string) *client.Client {
+	token, err := readLagoonToken(lagoon)
+	if err != nil {
+		handleError(fmt.Errorf("couldn't read token for lagoon %s: %w", lagoon, err))
+	}
+	lc, err := lagoonClient(lagoon, token)
+	handleError(err)
+	return lc
+}
+
+// lagoonClient returns a client for the API of the given lagoon which uses the
+// given token, and retries transient failures up to --retries times.
+func lagoonClient(lagoon, token string) (*client.Client, error) {
+	tlsConfig := lagoonTLSConfig(lagoon)
+	if tlsConfig.InsecureSkipVerify {
+		fmt.Fprintf(os.Stderr, "Warning: TLS certificate verification is disabled for lagoon %s\n", lagoon)
+	}
+	lc, err := client.NewWithTLS(
+		viper.GetString("lagoons."+lagoon+".graphql"),
+		token,
+		viper.GetString("lagoons."+lagoon+".version"),
+		debugEnable,
+		tlsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS config for lagoon %s: %w", lagoon, err)
 	}
+	retry := client.DefaultRetryPolicy
+	retry.MaxRetries = cmdRetries
+	lc.SetRetryPolicy(retry)
+	return lc, nil
+}
+
+// lagoonTLSConfig returns the TLS settings of the given lagoon in the config
+// file.
+func lagoonTLSConfig(lagoon string) client.TLSConfig {
+	return client.TLSConfig{
+		CAFile:             viper.GetString("lagoons." + lagoon + ".ca-file"),
+		ClientCert:         viper.GetString("lagoons." + lagoon + ".client-cert"),
+		ClientKey:          viper.GetString("lagoons." + lagoon + ".client-key"),
+		InsecureSkipVerify: viper.GetBool("lagoons." + lagoon + ".insecure-skip-verify"),
+	}
+}
+
+// noSpaces replaces the spaces in a value with underscores, to make table
+// output friendly for parsing with awk.
+func noSpaces(value string) string {
+	return strings.Replace(value, " ", "_", -1)
+}
+
+// isEnvType returns true if the given environment type is envType. The Lagoon
+// API returns environment types in lower case.
+func isEnvType(t, envType api.EnvType) bool {
+	return strings.EqualFold(string(t), string(envType))
+}
+
+// isDeployStatus returns true if the given deployment status is status,
+// ignoring case like isEnvType.
+func isDeployStatus(s, status api.DeploymentStatusType) bool {
+	return strings.EqualFold(string(s), string(status))
+}
+
+// isTaskStatus returns true if the given task status is status, ignoring case
+// like isEnvType.
+func isTaskStatus(s, status api.TaskStatusType) bool {
+	return strings.EqualFold(string(s), string(status))
 }
@@ -32,28 +32,26 @@ var sshEnvCmd = &cobra.Command{
 			"port":     viper.GetString("lagoons." + cmdLagoon + ".port"),
 			"username": cmdProjectName + "-" + cmdProjectEnvironment,
 		}
+		if hostKeyConfig := lagoonHostKeyConfig(cmdLagoon); len(hostKeyConfig.Fingerprints) == 0 {
+			sshConfig["knownhosts"] = hostKeyConfig.KnownHostsFile + " " + hostKeyConfig.UserKnownHostsFile
+		}
 		if sshConnString {
 			fmt.Println(lagoonssh.GenerateSSHConnectionString(sshConfig, sshService, sshContainer))
 		} else {
-			// get private key that the cli is using
-			skipAgent := false
-
-			privateKey := fmt.Sprintf("%s/.ssh/id_rsa", userPath)
-			if cmdSSHKey != "" {
-				privateKey = cmdSSHKey
-				skipAgent = true
-			}
 			// start an interactive ssh session
-			authMethod, closeSSHAgent := publicKey(privateKey, skipAgent)
+			authMethod, closeSSHAgent, err := lagoonSSHAuth(cmdLagoon)
+			handleError(err)
+			hostKeyConfig := lagoonHostKeyConfig(cmdLagoon)
 			config := &ssh.ClientConfig{
 				User: sshConfig["username"],
 				Auth: []ssh.AuthMethod{
 					authMethod,
 				},
-				HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+				HostKeyCallback: hostKeyConfig.HostKeyCallback(),
+				HostKeyAlgorithms: hostKeyConfig.HostKeyAlgorithms(
+					sshConfig["hostname"] + ":" + sshConfig["port"]),
 			}
 			defer closeSSHAgent()
-			var err error
 			if sshCommand != "" {
 				err = lagoonssh.RunSSHCommand(sshConfig, sshService, sshContainer, sshCommand, config)
 			} else {
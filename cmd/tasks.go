@@ -2,12 +2,15 @@ package cmd
 
 import (
 	"bufio"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
 	"github.com/amazeeio/lagoon-cli/pkg/api"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
@@ -23,16 +26,10 @@ var runDrushArchiveDump = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		taskResult, err := eClient.RunDrushArchiveDump(cmdProjectName, cmdProjectEnvironment)
+		task, err := lagoon.RunDrushArchiveDump(cmdContext, newLagoonClient(),
+			cmdProjectName, cmdProjectEnvironment)
 		handleError(err)
-		var resultMap map[string]interface{}
-		err = json.Unmarshal([]byte(taskResult), &resultMap)
-		handleError(err)
-		resultData := output.Result{
-			Result:     "success",
-			ResultData: resultMap,
-		}
-		output.RenderResult(resultData, outputOptions)
+		renderTask(task)
 	},
 }
 
@@ -46,16 +43,10 @@ var runDrushSQLDump = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		taskResult, err := eClient.RunDrushSQLDump(cmdProjectName, cmdProjectEnvironment)
-		handleError(err)
-		var resultMap map[string]interface{}
-		err = json.Unmarshal([]byte(taskResult), &resultMap)
+		task, err := lagoon.RunDrushSQLDump(cmdContext, newLagoonClient(),
+			cmdProjectName, cmdProjectEnvironment)
 		handleError(err)
-		resultData := output.Result{
-			Result:     "success",
-			ResultData: resultMap,
-		}
-		output.RenderResult(resultData, outputOptions)
+		renderTask(task)
 	},
 }
 
@@ -69,16 +60,10 @@ var runDrushCacheClear = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		taskResult, err := eClient.RunDrushCacheClear(cmdProjectName, cmdProjectEnvironment)
-		handleError(err)
-		var resultMap map[string]interface{}
-		err = json.Unmarshal([]byte(taskResult), &resultMap)
+		task, err := lagoon.RunDrushCacheClear(cmdContext, newLagoonClient(),
+			cmdProjectName, cmdProjectEnvironment)
 		handleError(err)
-		resultData := output.Result{
-			Result:     "success",
-			ResultData: resultMap,
-		}
-		output.RenderResult(resultData, outputOptions)
+		renderTask(task)
 	},
 }
 
@@ -124,24 +109,58 @@ Path:
 			cmd.Help()
 			os.Exit(1)
 		}
-		task := api.Task{
-			Name:    taskName,
-			Command: taskCommand,
-			Service: taskService,
-		}
-		taskResult, err := eClient.RunCustomTask(cmdProjectName, cmdProjectEnvironment, task)
+		task, err := lagoon.RunCustomTask(cmdContext, newLagoonClient(),
+			cmdProjectName, cmdProjectEnvironment, schema.AddTaskInput{
+				Name:    taskName,
+				Command: taskCommand,
+				Service: taskService,
+			})
 		handleError(err)
-		var resultMap map[string]interface{}
-		err = json.Unmarshal([]byte(taskResult), &resultMap)
-		handleError(err)
-		resultData := output.Result{
-			Result:     "success",
-			ResultData: resultMap,
-		}
-		output.RenderResult(resultData, outputOptions)
+		renderTask(task)
 	},
 }
 
+var (
+	taskWait         bool
+	taskPollInterval time.Duration
+)
+
+// errTaskFailed is returned by commands which waited for a task which failed,
+// errored or was cancelled.
+var errTaskFailed = errors.New("task failed")
+
+// renderTask renders the result of a run command, after waiting for its task
+// to finish if --wait is set.
+func renderTask(task *schema.Task) {
+	resultData := map[string]interface{}{"id": task.ID}
+	if taskWait {
+		output.RenderInfo(fmt.Sprintf("Waiting for task %d", task.ID), outputOptions)
+		finished, err := lagoon.WaitForTask(cmdContext, newLagoonClient(),
+			task.ID, taskPollInterval, func(task *schema.Task) {
+				output.RenderInfo(fmt.Sprintf("Task %d is %s", task.ID,
+					strings.ToLower(string(task.Status))), outputOptions)
+			})
+		handleError(err)
+		handleError(taskResult(finished))
+		resultData["status"] = finished.Status
+	}
+	output.RenderResult(output.Result{
+		Result:     "success",
+		ResultData: resultData,
+	}, outputOptions)
+}
+
+// taskResult returns an error wrapping errTaskFailed if a finished task didn't
+// succeed.
+func taskResult(task *schema.Task) error {
+	if !isTaskStatus(task.Status, api.SucceededTask) &&
+		!isTaskStatus(task.Status, api.CompleteTask) {
+		return fmt.Errorf("%w: task %d finished with status %s", errTaskFailed,
+			task.ID, task.Status)
+	}
+	return nil
+}
+
 var (
 	taskName        string
 	taskService     string
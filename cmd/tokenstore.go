@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/amazeeio/lagoon-cli/internal/tokenstore"
+	"github.com/spf13/viper"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// secretKeys are the keys of the secrets which are kept in the token store of
+// a lagoon.
+var secretKeys = []string{"token", "refresh-token"}
+
+// lagoonTokens are the tokens loaded or fetched by this command, by lagoon.
+var lagoonTokens = map[string]string{}
+
+// tokenStores are the token stores opened by this command, by name, so that
+// the passphrase of the encrypted file is asked for at most once.
+var tokenStores = map[string]tokenstore.Store{}
+
+// lagoonTokenStoreName returns the name of the token store configured for the
+// given lagoon.
+func lagoonTokenStoreName(lagoon string) string {
+	if name := viper.GetString("lagoons." + lagoon + ".token-store"); name != "" {
+		return name
+	}
+	return "file"
+}
+
+// tokenStore returns the token store with the given name.
+func tokenStore(name string) (tokenstore.Store, error) {
+	if store, ok := tokenStores[name]; ok {
+		return store, nil
+	}
+	var store tokenstore.Store
+	switch name {
+	case "file":
+		store = &tokenstore.File{Config: viper.GetViper()}
+	case "encrypted-file":
+		store = &tokenstore.EncryptedFile{
+			Path:       filepath.Join(userPath, ".lagoon-tokens"),
+			Passphrase: tokenStorePassphrase,
+		}
+	case "keyring":
+		store = &tokenstore.Keyring{Service: "lagoon-cli"}
+	default:
+		return nil, fmt.Errorf(
+			"unknown token store %q, must be file, encrypted-file or keyring", name)
+	}
+	tokenStores[name] = store
+	return store, nil
+}
+
+// tokenStorePassphrase returns the passphrase of the encrypted token file from
+// the LAGOON_TOKEN_PASSPHRASE environment variable, or prompts for it.
+func tokenStorePassphrase(create bool) (string, error) {
+	if passphrase := os.Getenv("LAGOON_TOKEN_PASSPHRASE"); passphrase != "" {
+		return passphrase, nil
+	}
+	fmt.Fprint(os.Stderr, "Enter passphrase for the Lagoon token file: ")
+	passphrase, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("couldn't read passphrase: %v", err)
+	}
+	if create {
+		fmt.Fprint(os.Stderr, "Confirm passphrase: ")
+		confirm, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", fmt.Errorf("couldn't read passphrase: %v", err)
+		}
+		if string(confirm) != string(passphrase) {
+			return "", fmt.Errorf("passphrases don't match")
+		}
+	}
+	return string(passphrase), nil
+}
+
+// openLagoonTokenStore returns the token store of the given lagoon. Secrets
+// which are still in plaintext in the config file are moved into it.
+func openLagoonTokenStore(lagoon string) (tokenstore.Store, error) {
+	name := lagoonTokenStoreName(lagoon)
+	store, err := tokenStore(name)
+	if err != nil || name == "file" {
+		return store, err
+	}
+	file, _ := tokenStore("file")
+	if err = moveSecrets(lagoon, file, store); err != nil {
+		return nil, fmt.Errorf("couldn't move plaintext tokens to the %s token store: %w",
+			name, err)
+	}
+	return store, nil
+}
+
+// moveSecrets moves the secrets of the given lagoon from one token store to
+// another.
+func moveSecrets(lagoon string, from, to tokenstore.Store) error {
+	for _, key := range secretKeys {
+		secret, err := from.Get(lagoon, key)
+		if err != nil {
+			return err
+		}
+		if secret == "" {
+			continue
+		}
+		if err = to.Set(lagoon, key, secret); err != nil {
+			return err
+		}
+		if err = from.Delete(lagoon, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lagoonSecret returns the secret stored for the given lagoon under key.
+func lagoonSecret(lagoon, key string) (string, error) {
+	store, err := openLagoonTokenStore(lagoon)
+	if err != nil {
+		return "", err
+	}
+	return store.Get(lagoon, key)
+}
+
+// setLagoonSecret stores the secret for the given lagoon under key.
+func setLagoonSecret(lagoon, key, secret string) error {
+	store, err := openLagoonTokenStore(lagoon)
+	if err != nil {
+		return err
+	}
+	return store.Set(lagoon, key, secret)
+}
+
+// peekLagoonToken returns the API token of the given lagoon like
+// readLagoonToken, but doesn't move plaintext tokens into its token store.
+// A token store which fails, for example because of a wrong passphrase, isn't
+// tried again for other lagoons.
+func peekLagoonToken(lagoon string) (string, error) {
+	if token, ok := lagoonTokens[lagoon]; ok {
+		return token, nil
+	}
+	if !savesToken(lagoonAuthMethod(lagoon)) {
+		return "", nil
+	}
+	name := lagoonTokenStoreName(lagoon)
+	if err, ok := peekErrs[name]; ok {
+		return "", err
+	}
+	store, err := tokenStore(name)
+	if err != nil {
+		return "", err
+	}
+	token, err := store.Get(lagoon, "token")
+	if err != nil {
+		peekErrs[name] = err
+		return "", err
+	}
+	if token == "" && name != "file" {
+		// the token may still be in plaintext in the config file
+		file, _ := tokenStore("file")
+		return file.Get(lagoon, "token")
+	}
+	return token, nil
+}
+
+// peekErrs are the errors of the token stores which failed in
+// peekLagoonToken, by name.
+var peekErrs = map[string]error{}
+
+// readLagoonToken returns the API token of the given lagoon.
+func readLagoonToken(lagoon string) (string, error) {
+	if token, ok := lagoonTokens[lagoon]; ok {
+		return token, nil
+	}
+	if !savesToken(lagoonAuthMethod(lagoon)) {
+		return "", nil
+	}
+	token, err := lagoonSecret(lagoon, "token")
+	if err != nil {
+		return "", err
+	}
+	lagoonTokens[lagoon] = token
+	return token, nil
+}
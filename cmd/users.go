@@ -1,33 +1,19 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"strings"
 
 	"github.com/amazeeio/lagoon-cli/internal/helpers"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
 	"github.com/amazeeio/lagoon-cli/pkg/api"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
-	"github.com/spf13/pflag"
 )
 
-func parseUser(flags pflag.FlagSet) api.User {
-	configMap := make(map[string]interface{})
-	flags.VisitAll(func(f *pflag.Flag) {
-		if flags.Changed(f.Name) {
-			configMap[f.Name] = f.Value
-		}
-	})
-	jsonStr, _ := json.Marshal(configMap)
-	parsedFlags := api.User{}
-	json.Unmarshal(jsonStr, &parsedFlags)
-	return parsedFlags
-}
-
-func parseSSHKeyFile(sshPubKey string, keyName string, keyValue string, userEmail string) api.SSHKey {
+func parseSSHKeyFile(sshPubKey string, keyName string, keyValue string, userEmail string) schema.SSHKey {
 	// if we haven't got a keyvalue
 	if keyValue == "" {
 		b, err := ioutil.ReadFile(sshPubKey) // just pass the file name
@@ -50,7 +36,7 @@ func parseSSHKeyFile(sshPubKey string, keyName string, keyValue string, userEmai
 		keyName = userEmail
 		output.RenderInfo("no name provided, using email address as key name", outputOptions)
 	}
-	parsedFlags := api.SSHKey{
+	parsedFlags := schema.SSHKey{
 		KeyType:  keyType,
 		KeyValue: helpers.StripNewLines(splitKey[1]),
 		Name:     keyName,
@@ -63,22 +49,21 @@ var addUserCmd = &cobra.Command{
 	Aliases: []string{"u"},
 	Short:   "Add a user to lagoon",
 	Run: func(cmd *cobra.Command, args []string) {
-		userFlags := parseUser(*cmd.Flags())
-		if userFlags.Email == "" {
+		if userEmail == "" {
 			fmt.Println("Missing arguments: Email address is not defined")
 			cmd.Help()
 			os.Exit(1)
 		}
-		var customReqResult []byte
-		var err error
-		customReqResult, err = uClient.AddUser(userFlags)
-		handleError(err)
-		returnResultData := map[string]interface{}{}
-		err = json.Unmarshal([]byte(customReqResult), &returnResultData)
+		user := schema.User{}
+		err := newLagoonClient().AddUser(cmdContext, &schema.AddUserInput{
+			Email:     userEmail,
+			FirstName: userFirstName,
+			LastName:  userLastName,
+		}, &user)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: returnResultData,
+			ResultData: map[string]interface{}{"id": user.ID, "email": user.Email},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
@@ -105,23 +90,21 @@ Add key by defining key value, but not specifying a key name (will default to tr
 
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		userFlags := parseUser(*cmd.Flags())
-		if userFlags.Email == "" {
+		if userEmail == "" {
 			fmt.Println("Missing arguments: Email address is not defined")
 			cmd.Help()
 			os.Exit(1)
 		}
-		userSSHKey := parseSSHKeyFile(pubKeyFile, sshKeyName, pubKeyValue, userFlags.Email)
-		var customReqResult []byte
-		var err error
-		customReqResult, err = uClient.AddSSHKeyToUser(userFlags, userSSHKey)
-		handleError(err)
-		returnResultData := map[string]interface{}{}
-		err = json.Unmarshal([]byte(customReqResult), &returnResultData)
+		userSSHKey := parseSSHKeyFile(pubKeyFile, sshKeyName, pubKeyValue, userEmail)
+		sshKey := schema.SSHKey{}
+		err := newLagoonClient().AddSSHKey(cmdContext, &schema.AddSSHKeyInput{
+			SSHKey:    userSSHKey,
+			UserEmail: userEmail,
+		}, &sshKey)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: returnResultData,
+			ResultData: map[string]interface{}{"name": sshKey.Name},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
@@ -137,13 +120,13 @@ var deleteSSHKeyCmd = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		var customReqResult []byte
-		var err error
 		if yesNo(fmt.Sprintf("You are attempting to delete ssh key named '%s', are you sure?", sshKeyName)) {
-			customReqResult, err = uClient.DeleteSSHKey(sshKeyName)
+			var result string
+			err := newLagoonClient().DeleteSSHKey(cmdContext,
+				&schema.DeleteSSHKeyInput{Name: sshKeyName}, &result)
 			handleError(err)
 			resultData := output.Result{
-				Result: string(customReqResult),
+				Result: result,
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -155,19 +138,19 @@ var deleteUserCmd = &cobra.Command{
 	Aliases: []string{"u"},
 	Short:   "Delete a user from lagoon",
 	Run: func(cmd *cobra.Command, args []string) {
-		userFlags := parseUser(*cmd.Flags())
-		if userFlags.Email == "" {
+		if userEmail == "" {
 			fmt.Println("Missing arguments: Email address is not defined")
 			cmd.Help()
 			os.Exit(1)
 		}
-		var customReqResult []byte
-		var err error
-		if yesNo(fmt.Sprintf("You are attempting to delete user with email address '%s', are you sure?", userFlags.Email)) {
-			customReqResult, err = uClient.DeleteUser(userFlags)
+		if yesNo(fmt.Sprintf("You are attempting to delete user with email address '%s', are you sure?", userEmail)) {
+			var result string
+			err := newLagoonClient().DeleteUser(cmdContext, &schema.DeleteUserInput{
+				User: schema.UserInput{Email: userEmail},
+			}, &result)
 			handleError(err)
 			resultData := output.Result{
-				Result: string(customReqResult),
+				Result: result,
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -180,25 +163,24 @@ var updateUserCmd = &cobra.Command{
 	Short:   "Update a user in lagoon",
 	Long:    "Update a user in lagoon (change name, or email address)",
 	Run: func(cmd *cobra.Command, args []string) {
-		userFlags := parseUser(*cmd.Flags())
-		if userFlags.Email == "" {
-			fmt.Println("Missing arguments: Email address is not defined")
+		if currentUserEmail == "" {
+			fmt.Println("Missing arguments: Current email address is not defined")
 			cmd.Help()
 			os.Exit(1)
 		}
-		var customReqResult []byte
-		var err error
-		currentUser := api.User{
-			Email: currentUserEmail,
-		}
-		customReqResult, err = uClient.ModifyUser(currentUser, userFlags)
-		handleError(err)
-		returnResultData := map[string]interface{}{}
-		err = json.Unmarshal([]byte(customReqResult), &returnResultData)
+		user := schema.User{}
+		err := newLagoonClient().UpdateUser(cmdContext, &schema.UpdateUserInput{
+			User: schema.UserInput{Email: currentUserEmail},
+			Patch: schema.UpdateUserPatchInput{
+				Email:     userEmail,
+				FirstName: userFirstName,
+				LastName:  userLastName,
+			},
+		}, &user)
 		handleError(err)
 		resultData := output.Result{
 			Result:     "success",
-			ResultData: returnResultData,
+			ResultData: map[string]interface{}{"id": user.ID, "email": user.Email},
 		}
 		output.RenderResult(resultData, outputOptions)
 	},
@@ -216,11 +198,10 @@ var getUserKeysCmd = &cobra.Command{
 			cmd.Help()
 			os.Exit(1)
 		}
-		returnedJSON, err := uClient.ListUserSSHKeys(groupName, userEmail, false)
-		handleError(err)
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		var groups []schema.Group
+		err := newLagoonClient().AllGroups(cmdContext, groupName, &groups)
 		handleError(err)
+		dataMain := userKeysTable(groups, userEmail)
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -237,11 +218,10 @@ var getAllUserKeysCmd = &cobra.Command{
 	Short:   "Get all user SSH keys",
 	Long:    `Get all user SSH keys. This will only work for users that are part of a group`,
 	Run: func(cmd *cobra.Command, args []string) {
-		returnedJSON, err := uClient.ListUserSSHKeys(groupName, userEmail, true)
-		handleError(err)
-		var dataMain output.Table
-		err = json.Unmarshal([]byte(returnedJSON), &dataMain)
+		var groups []schema.Group
+		err := newLagoonClient().AllGroups(cmdContext, groupName, &groups)
 		handleError(err)
+		dataMain := userKeysTable(groups, "")
 		if len(dataMain.Data) == 0 {
 			output.RenderError(noDataError, outputOptions)
 			os.Exit(1)
@@ -251,6 +231,76 @@ var getAllUserKeysCmd = &cobra.Command{
 	},
 }
 
+// usersTable returns a table of the members of the given groups, with a row
+// for each group a user is a member of.
+func usersTable(groups []schema.Group) output.Table {
+	data := []output.Data{}
+	seen := map[string]bool{}
+	for _, group := range groups {
+		for _, member := range group.Members {
+			var id string
+			if member.User.ID != nil {
+				id = member.User.ID.String()
+			}
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			// list every group membership of this user
+			for _, g := range groups {
+				for _, m := range g.Members {
+					if m.User.Email != member.User.Email {
+						continue
+					}
+					data = append(data, []string{
+						helpers.ReturnNonEmptyString(id),
+						helpers.ReturnNonEmptyString(noSpaces(member.User.Email)),
+						helpers.ReturnNonEmptyString(noSpaces(member.User.FirstName)),
+						helpers.ReturnNonEmptyString(noSpaces(member.User.LastName)),
+						helpers.ReturnNonEmptyString(noSpaces(g.Name)),
+						helpers.ReturnNonEmptyString(noSpaces(string(m.Role))),
+					})
+				}
+			}
+		}
+	}
+	return output.Table{
+		Header: []string{"ID", "Name", "FirstName", "LastName", "Group", "Role"},
+		Data:   data,
+	}
+}
+
+// userKeysTable returns a table of the SSH keys of the members of the given
+// groups. If email is not empty only the keys of that user are listed.
+func userKeysTable(groups []schema.Group, email string) output.Table {
+	data := []output.Data{}
+	seen := map[string]bool{}
+	for _, group := range groups {
+		for _, member := range group.Members {
+			if email != "" && member.User.Email != email {
+				continue
+			}
+			for _, key := range member.User.SSHKeys {
+				// users may be members of several groups
+				if seen[member.User.Email+" "+key.KeyValue] {
+					continue
+				}
+				seen[member.User.Email+" "+key.KeyValue] = true
+				data = append(data, []string{
+					helpers.ReturnNonEmptyString(noSpaces(member.User.Email)),
+					helpers.ReturnNonEmptyString(noSpaces(key.Name)),
+					helpers.ReturnNonEmptyString(noSpaces(string(key.KeyType))),
+					helpers.ReturnNonEmptyString(noSpaces(key.KeyValue)),
+				})
+			}
+		}
+	}
+	return output.Table{
+		Header: []string{"Email", "Name", "Type", "Value"},
+		Data:   data,
+	}
+}
+
 var (
 	currentUserEmail string
 	pubKeyValue      string
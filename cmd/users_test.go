@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+func TestUsersTable(t *testing.T) {
+	var groupList = `[{"id":"21ab7da7-4dc7-4745-92ef-a9faf663b8a4","members":[],"name":"High Cotton Billing Group"},{"id":"07b3d263-3a3e-4d0e-ac3f-56eab1e80df9","members":[{"role":"OWNER","user":{"email":"ci-customer-user-ed25519@example.com","firstName":null,"id":"23781ccd-8e35-4206-a7cf-97153311ba91","lastName":null}},{"role":"OWNER","user":{"email":"ci-customer-user-rsa@example.com","firstName":null,"id":"906391b3-b3b2-43cb-82e7-fa0c07007fbc","lastName":null}}],"name":"ci-group"},{"id":"f6786ff8-4eea-476a-8462-25931c0b2724","members":[{"role":"OWNER","user":{"email":"credentialtestbothgroupaccess_user@example.com","firstName":null,"id":"678707fd-0d01-458d-981f-acae396624bb","lastName":null}}],"name":"credentialtest-group1"},{"id":"8349ffb3-d940-445e-a610-4bb6f3ba8a0f","members":[{"role":"OWNER","user":{"email":"credentialtestbothgroupaccess_user@example.com","firstName":null,"id":"678707fd-0d01-458d-981f-acae396624bb","lastName":null}}],"name":"credentialtest-group2"},{"id":"94311670-e817-4335-b440-25a92e1ac83f","members":[{"role":"MAINTAINER","user":{"email":"ci-customer-user-rsa@example.com","firstName":null,"id":"906391b3-b3b2-43cb-82e7-fa0c07007fbc","lastName":null}}],"name":"project-ci-api"}]`
+	var expect = `{"header":["ID","Name","FirstName","LastName","Group","Role"],"data":[["23781ccd-8e35-4206-a7cf-97153311ba91","ci-customer-user-ed25519@example.com","-","-","ci-group","OWNER"],["906391b3-b3b2-43cb-82e7-fa0c07007fbc","ci-customer-user-rsa@example.com","-","-","ci-group","OWNER"],["906391b3-b3b2-43cb-82e7-fa0c07007fbc","ci-customer-user-rsa@example.com","-","-","project-ci-api","MAINTAINER"],["678707fd-0d01-458d-981f-acae396624bb","credentialtestbothgroupaccess_user@example.com","-","-","credentialtest-group1","OWNER"],["678707fd-0d01-458d-981f-acae396624bb","credentialtestbothgroupaccess_user@example.com","-","-","credentialtest-group2","OWNER"]]}`
+	var groups []schema.Group
+	if err := json.Unmarshal([]byte(groupList), &groups); err != nil {
+		t.Fatalf("couldn't unmarshal groups: %v", err)
+	}
+	checkTable(t, usersTable(groups), expect)
+}
+
+func TestUserKeysTable(t *testing.T) {
+	var groupList = `[{"id":"07b3d263-3a3e-4d0e-ac3f-56eab1e80df9","members":[{"role":"MAINTAINER","user":{"email":"c@c.com","firstName":"bob","id":"a08f166e-64f0-4461-9daa-e62b6f414faf","lastName":"bob","sshKeys":[{"keyType":"ssh-rsa","keyValue":"AAAAB3NzaC1yc2EAAAADAQABAAACAQC++bRFdPP6d3kdXv1eImtf","name":"deploy@nhmrc"}]}},{"role":"OWNER","user":{"email":"ci-customer-user-ed25519@example.com","firstName":null,"id":"23781ccd-8e35-4206-a7cf-97153311ba91","lastName":null,"sshKeys":[{"keyType":"ssh-ed25519","keyValue":"AAAAC3NzaC1lZDI1NTE5AAAAIMdEs1h19jv2UrbtKcqPDatUxT9lPYcbGlEAbInsY8Ka","name":"ci-customer-sshkey-ed25519"}]}}],"name":"ci-group"},{"id":"94311670-e817-4335-b440-25a92e1ac83f","members":[{"role":"OWNER","user":{"email":"c@c.com","firstName":"bob","id":"a08f166e-64f0-4461-9daa-e62b6f414faf","lastName":"bob","sshKeys":[{"keyType":"ssh-rsa","keyValue":"AAAAB3NzaC1yc2EAAAADAQABAAACAQC++bRFdPP6d3kdXv1eImtf","name":"deploy@nhmrc"}]}}],"name":"project-ci-api"}]`
+	var testCases = map[string]struct {
+		email  string
+		expect string
+	}{
+		"all": {
+			expect: `{"header":["Email","Name","Type","Value"],"data":[["c@c.com","deploy@nhmrc","ssh-rsa","AAAAB3NzaC1yc2EAAAADAQABAAACAQC++bRFdPP6d3kdXv1eImtf"],["ci-customer-user-ed25519@example.com","ci-customer-sshkey-ed25519","ssh-ed25519","AAAAC3NzaC1lZDI1NTE5AAAAIMdEs1h19jv2UrbtKcqPDatUxT9lPYcbGlEAbInsY8Ka"]]}`,
+		},
+		"specificUser": {
+			email:  "c@c.com",
+			expect: `{"header":["Email","Name","Type","Value"],"data":[["c@c.com","deploy@nhmrc","ssh-rsa","AAAAB3NzaC1yc2EAAAADAQABAAACAQC++bRFdPP6d3kdXv1eImtf"]]}`,
+		},
+	}
+	var groups []schema.Group
+	if err := json.Unmarshal([]byte(groupList), &groups); err != nil {
+		t.Fatalf("couldn't unmarshal groups: %v", err)
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			checkTable(tt, userKeysTable(groups, tc.email), tc.expect)
+		})
+	}
+}
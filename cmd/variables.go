@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
 	"github.com/amazeeio/lagoon-cli/pkg/api"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
@@ -20,7 +22,7 @@ type EnvironmentVariableFlags struct {
 	Scope string `json:"scope,omitempty"`
 }
 
-func parseEnvVars(flags pflag.FlagSet) api.EnvVariable {
+func parseEnvVars(flags pflag.FlagSet) schema.EnvKeyValue {
 	configMap := make(map[string]interface{})
 	flags.VisitAll(func(f *pflag.Flag) {
 		if flags.Changed(f.Name) {
@@ -28,7 +30,7 @@ func parseEnvVars(flags pflag.FlagSet) api.EnvVariable {
 		}
 	})
 	jsonStr, _ := json.Marshal(configMap)
-	parsedFlags := api.EnvVariable{}
+	parsedFlags := schema.EnvKeyValue{}
 	json.Unmarshal(jsonStr, &parsedFlags)
 	return parsedFlags
 }
@@ -62,22 +64,15 @@ var addVariableCmd = &cobra.Command{
 			output.RenderError("Unknown scope: "+string(envVarFlags.Scope), outputOptions)
 			os.Exit(1)
 		}
-		var customReqResult []byte
-		var err error
 		returnResultData := map[string]interface{}{}
+		returnResultData["Project"] = cmdProjectName
 		if cmdProjectEnvironment != "" {
-			customReqResult, err = eClient.AddEnvironmentVariableToEnvironment(cmdProjectName, cmdProjectEnvironment, envVarFlags)
-			returnResultData["Project"] = cmdProjectName
 			returnResultData["Environment"] = cmdProjectEnvironment
-		} else {
-			customReqResult, err = pClient.AddEnvironmentVariableToProject(cmdProjectName, envVarFlags)
-			returnResultData["Project"] = cmdProjectName
 		}
+		updatedVariable, err := lagoon.AddEnvVariable(cmdContext, newLagoonClient(),
+			cmdProjectName, cmdProjectEnvironment, envVarFlags)
 		handleError(err)
-		var updatedVariable api.EnvVariable
-		err = json.Unmarshal([]byte(customReqResult), &updatedVariable)
-		handleError(err)
-		returnResultData["ID"] = strconv.Itoa(updatedVariable.ID)
+		returnResultData["ID"] = strconv.Itoa(int(updatedVariable.ID))
 		resultData := output.Result{
 			Result:     "success",
 			ResultData: returnResultData,
@@ -112,16 +107,11 @@ var deleteVariableCmd = &cobra.Command{
 			deleteMsg = fmt.Sprintf("You are attempting to delete variable '%s' from environment '%s' in project '%s', are you sure?", envVarFlags.Name, cmdProjectEnvironment, cmdProjectName)
 		}
 		if yesNo(deleteMsg) {
-			var deleteResult []byte
-			var err error
-			if cmdProjectEnvironment != "" {
-				deleteResult, err = eClient.DeleteEnvironmentVariableFromEnvironment(cmdProjectName, cmdProjectEnvironment, envVarFlags)
-			} else {
-				deleteResult, err = pClient.DeleteEnvironmentVariableFromProject(cmdProjectName, envVarFlags)
-			}
+			deleteResult, err := lagoon.DeleteEnvVariable(cmdContext, newLagoonClient(),
+				cmdProjectName, cmdProjectEnvironment, envVarFlags.Name)
 			handleError(err)
 			resultData := output.Result{
-				Result: string(deleteResult),
+				Result: deleteResult,
 			}
 			output.RenderResult(resultData, outputOptions)
 		}
@@ -1,13 +1,11 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
 	"github.com/amazeeio/lagoon-cli/internal/helpers"
 	"github.com/amazeeio/lagoon-cli/internal/lagoon"
-	"github.com/amazeeio/lagoon-cli/internal/lagoon/client"
 	"github.com/amazeeio/lagoon-cli/pkg/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -24,18 +22,9 @@ This is useful if you have multiple keys or accounts in multiple lagoons and nee
 		return validateTokenE(viper.GetString("current"))
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		debug, err := cmd.Flags().GetBool("debug")
-		if err != nil {
-			return err
-		}
-		current := viper.GetString("current")
-		lc := client.New(
-			viper.GetString("lagoons."+current+".graphql"),
-			viper.GetString("lagoons."+current+".token"),
-			viper.GetString("lagoons."+current+".version"),
-			debug)
+		lc := newLagoonClient()
 
-		user, err := lagoon.GetMeInfo(context.TODO(), lc)
+		user, err := lagoon.GetMeInfo(cmdContext, lc)
 		if err != nil {
 			if strings.Contains(err.Error(), "Cannot read property 'access_token' of null") {
 				return fmt.Errorf("Unable to get user information, you may be using an administration token")
@@ -0,0 +1,10 @@
+// Package auth implements the ways the CLI can obtain a token for the Lagoon
+// API.
+package auth
+
+import "context"
+
+// A TokenSource obtains a token for the Lagoon API.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
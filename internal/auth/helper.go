@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Helper obtains a token from an external credential helper command, in the
+// style of git credential helpers. The command is run by the shell with the
+// argument "get", and with lagoon=<name> and graphql=<endpoint> lines on
+// stdin. It must print the token on stdout, either on its own or as a
+// token=<token> line.
+type Helper struct {
+	// Command is the credential helper command line.
+	Command string
+	// Lagoon is the name of the lagoon in the config file.
+	Lagoon string
+	// GraphQL is the API endpoint of the lagoon.
+	GraphQL string
+}
+
+// Token implements TokenSource.
+func (h *Helper) Token(ctx context.Context) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", h.Command+" get")
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", h.Command+" get")
+	}
+	cmd.Stdin = strings.NewReader(
+		fmt.Sprintf("lagoon=%s\ngraphql=%s\n\n", h.Lagoon, h.GraphQL))
+	cmd.Stderr = os.Stderr
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("credential helper failed: %v", err)
+	}
+	out := strings.TrimSpace(stdout.String())
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "token=") {
+			out = strings.TrimSpace(strings.TrimPrefix(line, "token="))
+			break
+		}
+	}
+	if out == "" {
+		return "", fmt.Errorf("credential helper returned no token")
+	}
+	return out, nil
+}
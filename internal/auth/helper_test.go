@@ -0,0 +1,46 @@
+package auth_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/auth"
+)
+
+func TestHelper(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test helpers are shell scripts")
+	}
+	var testCases = map[string]struct {
+		command     string
+		expectToken string
+		expectErr   string
+	}{
+		"raw token": {
+			command:     "echo rawtoken; true",
+			expectToken: "rawtoken",
+		},
+		"token line": {
+			// the helper gets the lagoon on stdin, and "get" as an argument
+			command:     `f() { grep -q lagoon=ci && echo "user=x" && echo "token=$1-ok"; }; f`,
+			expectToken: "get-ok",
+		},
+		"failure": {
+			command:   "false",
+			expectErr: "credential helper failed",
+		},
+		"no token": {
+			command:   "true",
+			expectErr: "credential helper returned no token",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			h := auth.Helper{Command: tc.command, Lagoon: "ci",
+				GraphQL: "https://api.example.com/graphql"}
+			token, err := h.Token(context.Background())
+			checkToken(tt, token, err, tc.expectToken, tc.expectErr)
+		})
+	}
+}
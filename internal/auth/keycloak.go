@@ -0,0 +1,214 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// deviceCodeGrantType is the grant type of the OAuth 2.0 device authorization
+// grant, RFC 8628.
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// Keycloak obtains a token from the Keycloak instance of a Lagoon using the
+// OIDC device authorization grant or the resource owner password grant.
+type Keycloak struct {
+	// URL is the URL of the Lagoon realm, such as
+	// https://keycloak.example.com/auth/realms/lagoon.
+	URL string
+	// ClientID is the ID of the Keycloak client.
+	ClientID string
+	// Grant is "device" (the default) or "password".
+	Grant string
+	// Username is the username for the password grant.
+	Username string
+	// Password returns the password for the password grant.
+	Password func() (string, error)
+	// RefreshToken is used to obtain a token without user interaction if it
+	// is set. Token updates it with the refresh token returned by Keycloak.
+	RefreshToken string
+	// HTTPClient is the client used for requests to Keycloak. If nil,
+	// http.DefaultClient is used.
+	HTTPClient *http.Client
+	// Out is where the instructions for the device grant are written.
+	Out io.Writer
+}
+
+// tokenResponse is the response of the Keycloak token endpoint.
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (r *tokenResponse) err() error {
+	if r.ErrorDescription != "" {
+		return fmt.Errorf("keycloak: %s: %s", r.Error, r.ErrorDescription)
+	}
+	return fmt.Errorf("keycloak: %s", r.Error)
+}
+
+// deviceResponse is the response of the Keycloak device authorization
+// endpoint.
+type deviceResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+	Error                   string `json:"error"`
+}
+
+// Token implements TokenSource.
+func (k *Keycloak) Token(ctx context.Context) (string, error) {
+	if k.RefreshToken != "" {
+		r, err := k.requestToken(ctx, url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {k.RefreshToken},
+		})
+		if err != nil {
+			return "", err
+		}
+		if r.Error == "" {
+			return k.accept(r), nil
+		}
+		// the refresh token has expired, so fall back to the grant
+	}
+	switch k.Grant {
+	case "", "device":
+		return k.deviceGrant(ctx)
+	case "password":
+		return k.passwordGrant(ctx)
+	}
+	return "", fmt.Errorf("unknown keycloak grant %q", k.Grant)
+}
+
+// accept stores the refresh token of the response and returns its access
+// token.
+func (k *Keycloak) accept(r *tokenResponse) string {
+	k.RefreshToken = r.RefreshToken
+	return r.AccessToken
+}
+
+func (k *Keycloak) passwordGrant(ctx context.Context) (string, error) {
+	if k.Username == "" || k.Password == nil {
+		return "", fmt.Errorf("the password grant requires a username and password")
+	}
+	password, err := k.Password()
+	if err != nil {
+		return "", err
+	}
+	r, err := k.requestToken(ctx, url.Values{
+		"grant_type": {"password"},
+		"username":   {k.Username},
+		"password":   {password},
+	})
+	if err != nil {
+		return "", err
+	}
+	if r.Error != "" {
+		return "", r.err()
+	}
+	return k.accept(r), nil
+}
+
+func (k *Keycloak) deviceGrant(ctx context.Context) (string, error) {
+	var d deviceResponse
+	err := k.post(ctx, "/protocol/openid-connect/auth/device",
+		url.Values{"client_id": {k.ClientID}}, &d)
+	if err != nil {
+		return "", err
+	}
+	if d.Error != "" {
+		return "", fmt.Errorf("keycloak: %s", d.Error)
+	}
+	if k.Out != nil {
+		verificationURI := d.VerificationURIComplete
+		if verificationURI == "" {
+			verificationURI = d.VerificationURI
+		}
+		fmt.Fprintf(k.Out, "To log in, open %s in a browser and confirm the code %s\n",
+			verificationURI, d.UserCode)
+	}
+	interval := time.Duration(d.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	if d.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx,
+			time.Duration(d.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("device login wasn't completed: %w", ctx.Err())
+		case <-time.After(interval):
+		}
+		r, err := k.requestToken(ctx, url.Values{
+			"grant_type":  {deviceCodeGrantType},
+			"device_code": {d.DeviceCode},
+		})
+		if err != nil {
+			return "", err
+		}
+		switch r.Error {
+		case "":
+			return k.accept(r), nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		default:
+			return "", r.err()
+		}
+	}
+}
+
+// requestToken sends a request to the token endpoint. Errors returned by
+// Keycloak are set in the response rather than returned.
+func (k *Keycloak) requestToken(ctx context.Context,
+	form url.Values) (*tokenResponse, error) {
+	form.Set("client_id", k.ClientID)
+	var r tokenResponse
+	err := k.post(ctx, "/protocol/openid-connect/token", form, &r)
+	return &r, err
+}
+
+// post sends a form to the given path of the realm, and unmarshals the JSON
+// response into v.
+func (k *Keycloak) post(ctx context.Context, path string, form url.Values,
+	v interface{}) error {
+	req, err := http.NewRequest(http.MethodPost,
+		strings.TrimSuffix(k.URL, "/")+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpClient := k.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	res, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("couldn't reach keycloak: %w", err)
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("couldn't read keycloak response: %w", err)
+	}
+	if err = json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("unexpected keycloak response (status %d): %s",
+			res.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package auth_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/auth"
+)
+
+// fakeKeycloak is a Keycloak realm which issues the access token "access" and
+// the refresh token "refresh2".
+type fakeKeycloak struct {
+	mu       sync.Mutex
+	grants   []string
+	pending  int // number of device polls to answer with authorization_pending
+	password string
+}
+
+func (f *fakeKeycloak) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if r.Form.Get("client_id") != "lagoon-cli" {
+		fmt.Fprint(w, `{"error":"invalid_client"}`)
+		return
+	}
+	switch r.URL.Path {
+	case "/realm/protocol/openid-connect/auth/device":
+		fmt.Fprint(w, `{"device_code":"dc","user_code":"ABCD-EFGH",`+
+			`"verification_uri":"https://keycloak.example.com/device",`+
+			`"expires_in":60,"interval":1}`)
+		return
+	case "/realm/protocol/openid-connect/token":
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	grant := r.Form.Get("grant_type")
+	f.grants = append(f.grants, grant)
+	switch grant {
+	case "refresh_token":
+		if r.Form.Get("refresh_token") != "refresh" {
+			fmt.Fprint(w, `{"error":"invalid_grant","error_description":"Token is not active"}`)
+			return
+		}
+	case "password":
+		if r.Form.Get("username") != "user" || r.Form.Get("password") != f.password {
+			fmt.Fprint(w, `{"error":"invalid_grant","error_description":"Invalid user credentials"}`)
+			return
+		}
+	case "urn:ietf:params:oauth:grant-type:device_code":
+		if f.pending > 0 {
+			f.pending--
+			fmt.Fprint(w, `{"error":"authorization_pending"}`)
+			return
+		}
+	}
+	fmt.Fprint(w, `{"access_token":"access","refresh_token":"refresh2"}`)
+}
+
+func TestKeycloak(t *testing.T) {
+	var testCases = map[string]struct {
+		keycloak     auth.Keycloak
+		pending      int
+		expectGrants []string
+		expectOut    string
+		expectErr    string
+	}{
+		"refresh": {
+			keycloak:     auth.Keycloak{RefreshToken: "refresh"},
+			expectGrants: []string{"refresh_token"},
+		},
+		"expired refresh token": {
+			keycloak: auth.Keycloak{
+				RefreshToken: "expired",
+				Grant:        "password",
+				Username:     "user",
+				Password:     func() (string, error) { return "secret", nil },
+			},
+			expectGrants: []string{"refresh_token", "password"},
+		},
+		"wrong password": {
+			keycloak: auth.Keycloak{
+				Grant:    "password",
+				Username: "user",
+				Password: func() (string, error) { return "wrong", nil },
+			},
+			expectGrants: []string{"password"},
+			expectErr:    "keycloak: invalid_grant: Invalid user credentials",
+		},
+		"device": {
+			pending: 1,
+			expectGrants: []string{
+				"urn:ietf:params:oauth:grant-type:device_code",
+				"urn:ietf:params:oauth:grant-type:device_code",
+			},
+			expectOut: "To log in, open https://keycloak.example.com/device " +
+				"in a browser and confirm the code ABCD-EFGH\n",
+		},
+		"unknown grant": {
+			keycloak:  auth.Keycloak{Grant: "implicit"},
+			expectErr: `unknown keycloak grant "implicit"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			f := &fakeKeycloak{pending: tc.pending, password: "secret"}
+			ts := httptest.NewServer(f)
+			defer ts.Close()
+			var out bytes.Buffer
+			k := tc.keycloak
+			k.URL = ts.URL + "/realm/"
+			k.ClientID = "lagoon-cli"
+			k.Out = &out
+			token, err := k.Token(context.Background())
+			if tc.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectErr) {
+					tt.Fatalf("expected error %q, got %v", tc.expectErr, err)
+				}
+			} else {
+				if err != nil {
+					tt.Fatalf("unexpected error: %v", err)
+				}
+				if token != "access" {
+					tt.Fatalf("expected token access, got %s", token)
+				}
+				if k.RefreshToken != "refresh2" {
+					tt.Fatalf("expected refresh token refresh2, got %s",
+						k.RefreshToken)
+				}
+			}
+			if strings.Join(f.grants, ",") != strings.Join(tc.expectGrants, ",") {
+				tt.Fatalf("expected grants %v, got %v", tc.expectGrants, f.grants)
+			}
+			if out.String() != tc.expectOut {
+				tt.Fatalf("expected output %q, got %q", tc.expectOut, out.String())
+			}
+		})
+	}
+}
+
+func TestKeycloakDeviceCancel(t *testing.T) {
+	ts := httptest.NewServer(&fakeKeycloak{pending: 100})
+	defer ts.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	k := auth.Keycloak{URL: ts.URL + "/realm", ClientID: "lagoon-cli"}
+	_, err := k.Token(ctx)
+	if err == nil || !strings.Contains(err.Error(), "context canceled") {
+		t.Fatalf("expected context canceled error, got %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// SSH obtains a token by running the token command on the Lagoon SSH service.
+type SSH struct {
+	// Addr is the host:port of the Lagoon SSH service.
+	Addr string
+	// Config is the SSH client configuration, including the user, the auth
+	// methods and the host key callback.
+	Config *ssh.ClientConfig
+	// Cleanup is called once the token has been requested, e.g. to close the
+	// connection to an SSH agent. It may be nil.
+	Cleanup func() error
+}
+
+// Token implements TokenSource.
+func (s *SSH) Token(ctx context.Context) (string, error) {
+	if s.Cleanup != nil {
+		defer s.Cleanup()
+	}
+	conn, err := Dial(ctx, s.Addr, s.Config)
+	if err != nil {
+		return "", fmt.Errorf("couldn't connect to %s: %v", s.Addr, err)
+	}
+	defer conn.Close()
+	defer closeOnDone(ctx, conn)()
+
+	session, err := conn.NewSession()
+	if err != nil {
+		return "", fmt.Errorf("couldn't open session: %v", err)
+	}
+	out, err := session.CombinedOutput("token")
+	if err != nil {
+		return "", fmt.Errorf("couldn't get token: %v", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Dial is like ssh.Dial, except that the connection is closed if ctx is done
+// before the SSH handshake has finished.
+func Dial(ctx context.Context, addr string,
+	config *ssh.ClientConfig) (*ssh.Client, error) {
+	var dialer net.Dialer
+	netConn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer closeOnDone(ctx, netConn)()
+	c, chans, reqs, err := ssh.NewClientConn(netConn, addr, config)
+	if err != nil {
+		netConn.Close()
+		return nil, err
+	}
+	return ssh.NewClient(c, chans, reqs), nil
+}
+
+// closeOnDone closes c if ctx is done before the returned function is called.
+func closeOnDone(ctx context.Context, c io.Closer) func() {
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			c.Close()
+		case <-done:
+		}
+	}()
+	return func() { close(done) }
+}
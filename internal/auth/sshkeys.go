@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// DefaultKeyFiles returns the private key files tried by default, in order of
+// preference, in the .ssh directory of the given home directory.
+func DefaultKeyFiles(home string) []string {
+	return []string{
+		filepath.Join(home, ".ssh", "id_ed25519"),
+		filepath.Join(home, ".ssh", "id_ecdsa"),
+		filepath.Join(home, ".ssh", "id_rsa"),
+	}
+}
+
+// SSHKeys selects the SSH keys used to authenticate to a Lagoon SSH service.
+// Keys in the SSH agent are tried first, followed by the unencrypted private
+// key files in order. Encrypted key files are only decrypted if there are no
+// other keys, so that users of an agent aren't asked for passphrases.
+type SSHKeys struct {
+	// Files are the private key files to try, in order.
+	Files []string
+	// SkipMissing skips files which don't exist instead of returning an
+	// error, which is useful for DefaultKeyFiles.
+	SkipMissing bool
+	// AgentSocket is the path to the socket of the SSH agent, usually
+	// $SSH_AUTH_SOCK. If empty, no agent is used.
+	AgentSocket string
+	// Passphrase returns the passphrase of an encrypted private key file. If
+	// nil, encrypted key files can't be used.
+	Passphrase func(path string) ([]byte, error)
+}
+
+// encryptedKey is a private key file which needs a passphrase.
+type encryptedKey struct {
+	path string
+	pem  []byte
+}
+
+// AuthMethod returns an ssh.AuthMethod which tries all the selected keys, and
+// a function which releases the connection to the agent once authentication
+// has finished.
+func (k *SSHKeys) AuthMethod() (ssh.AuthMethod, func() error, error) {
+	var signers []ssh.Signer
+	closeAgent := func() error { return nil }
+	if k.AgentSocket != "" {
+		// the agent is optional, so it is skipped if it isn't running
+		if conn, err := net.Dial("unix", k.AgentSocket); err == nil {
+			agentSigners, err := agent.NewClient(conn).Signers()
+			if err == nil && len(agentSigners) > 0 {
+				signers = append(signers, agentSigners...)
+				closeAgent = conn.Close
+			} else {
+				conn.Close()
+			}
+		}
+	}
+
+	var encrypted []encryptedKey
+	for _, path := range k.Files {
+		data, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) && k.SkipMissing {
+			continue
+		}
+		if err != nil {
+			closeAgent()
+			return nil, nil, fmt.Errorf("couldn't read SSH key: %v", err)
+		}
+		signer, err := ssh.ParsePrivateKey(data)
+		var missing *ssh.PassphraseMissingError
+		if errors.As(err, &missing) {
+			encrypted = append(encrypted, encryptedKey{path: path, pem: data})
+			continue
+		}
+		if err != nil {
+			closeAgent()
+			return nil, nil, fmt.Errorf("couldn't parse SSH key %s: %v", path, err)
+		}
+		signers = append(signers, signer)
+	}
+
+	if len(signers) == 0 {
+		for _, key := range encrypted {
+			signer, err := k.decrypt(key)
+			if err != nil {
+				return nil, nil, err
+			}
+			signers = append(signers, signer)
+		}
+	}
+	if len(signers) == 0 {
+		return nil, nil, k.noKeysError()
+	}
+	return ssh.PublicKeys(signers...), closeAgent, nil
+}
+
+// decrypt asks for the passphrase of an encrypted key, and parses it.
+func (k *SSHKeys) decrypt(key encryptedKey) (ssh.Signer, error) {
+	if k.Passphrase == nil {
+		return nil, fmt.Errorf("SSH key %s is encrypted, but no passphrase "+
+			"is available: add it to ssh-agent or set SSH_ASKPASS", key.path)
+	}
+	passphrase, err := k.Passphrase(key.path)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get passphrase for SSH key %s: %v",
+			key.path, err)
+	}
+	signer, err := ssh.ParsePrivateKeyWithPassphrase(key.pem, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decrypt SSH key %s: %v", key.path, err)
+	}
+	return signer, nil
+}
+
+func (k *SSHKeys) noKeysError() error {
+	tried := append([]string{}, k.Files...)
+	if k.AgentSocket != "" {
+		tried = append([]string{"ssh-agent"}, tried...)
+	}
+	return fmt.Errorf("no SSH keys found, tried %s", strings.Join(tried, ", "))
+}
+
+// AskPass runs an SSH_ASKPASS style program with the given prompt as its
+// argument, and returns the passphrase it prints.
+func AskPass(command, prompt string) ([]byte, error) {
+	var stdout bytes.Buffer
+	cmd := exec.Command(command, prompt)
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s failed: %v", command, err)
+	}
+	return bytes.TrimRight(stdout.Bytes(), "\r\n"), nil
+}
@@ -0,0 +1,273 @@
+package auth_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/auth"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// authenticates returns true if method authenticates to a server which only
+// accepts the given key.
+func authenticates(t *testing.T, method ssh.AuthMethod, key ssh.PublicKey) bool {
+	t.Helper()
+	hostKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("couldn't generate host key: %v", err)
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		t.Fatalf("couldn't create host signer: %v", err)
+	}
+	serverConfig := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, k ssh.PublicKey) (*ssh.Permissions, error) {
+			if bytes.Equal(k.Marshal(), key.Marshal()) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unknown key")
+		},
+	}
+	serverConfig.AddHostKey(hostSigner)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("couldn't listen: %v", err)
+	}
+	defer l.Close()
+	go func() {
+		serverConn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer serverConn.Close()
+		conn, _, _, err := ssh.NewServerConn(serverConn, serverConfig)
+		if err == nil {
+			conn.Close()
+		}
+	}()
+	clientConn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("couldn't connect: %v", err)
+	}
+	defer clientConn.Close()
+	conn, _, _, err := ssh.NewClientConn(clientConn, "lagoon", &ssh.ClientConfig{
+		User:            "lagoon",
+		Auth:            []ssh.AuthMethod{method},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+func TestSSHKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lagoon-cli-sshkeys")
+	if err != nil {
+		t.Fatalf("couldn't create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// an unencrypted ECDSA key, an encrypted RSA key and an agent key
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("couldn't generate key: %v", err)
+	}
+	der, err := x509.MarshalECPrivateKey(ecdsaKey)
+	if err != nil {
+		t.Fatalf("couldn't marshal key: %v", err)
+	}
+	ecdsaFile := filepath.Join(dir, "id_ecdsa")
+	writeKey(t, ecdsaFile, &pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("couldn't generate key: %v", err)
+	}
+	block, err := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY",
+		x509.MarshalPKCS1PrivateKey(rsaKey), []byte("secret"), x509.PEMCipherAES256)
+	if err != nil {
+		t.Fatalf("couldn't encrypt key: %v", err)
+	}
+	rsaFile := filepath.Join(dir, "id_rsa")
+	writeKey(t, rsaFile, block)
+	// an ed25519 key in the OpenSSH format, encrypted with the passphrase
+	// secret
+	opensshFile := filepath.Join("testdata", "id_ed25519_encrypted")
+	opensshPub, err := ioutil.ReadFile(opensshFile + ".pub")
+	if err != nil {
+		t.Fatalf("couldn't read public key: %v", err)
+	}
+	opensshKey, _, _, _, err := ssh.ParseAuthorizedKey(opensshPub)
+	if err != nil {
+		t.Fatalf("couldn't parse public key: %v", err)
+	}
+	agentKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("couldn't generate key: %v", err)
+	}
+	keyring := agent.NewKeyring()
+	if err = keyring.Add(agent.AddedKey{PrivateKey: agentKey}); err != nil {
+		t.Fatalf("couldn't add key to agent: %v", err)
+	}
+	agentSocket := filepath.Join(dir, "agent.sock")
+	l, err := net.Listen("unix", agentSocket)
+	if err != nil {
+		t.Fatalf("couldn't listen: %v", err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go agent.ServeAgent(keyring, conn)
+		}
+	}()
+	publicKey := func(key interface{}) ssh.PublicKey {
+		signer, err := ssh.NewSignerFromKey(key)
+		if err != nil {
+			t.Fatalf("couldn't create signer: %v", err)
+		}
+		return signer.PublicKey()
+	}
+
+	missingFile := filepath.Join(dir, "id_ed25519")
+	var testCases = map[string]struct {
+		keys             auth.SSHKeys
+		passphrase       string
+		expectAuth       []ssh.PublicKey
+		expectPassphrase bool
+		expectErr        string
+	}{
+		"agent and files": {
+			keys: auth.SSHKeys{
+				Files:       []string{missingFile, ecdsaFile, rsaFile},
+				SkipMissing: true,
+				AgentSocket: agentSocket,
+			},
+			expectAuth: []ssh.PublicKey{publicKey(agentKey), publicKey(ecdsaKey)},
+		},
+		"agent not running": {
+			keys: auth.SSHKeys{
+				Files:       []string{ecdsaFile},
+				AgentSocket: filepath.Join(dir, "missing.sock"),
+			},
+			expectAuth: []ssh.PublicKey{publicKey(ecdsaKey)},
+		},
+		"encrypted key": {
+			keys:             auth.SSHKeys{Files: []string{rsaFile}},
+			passphrase:       "secret",
+			expectAuth:       []ssh.PublicKey{publicKey(rsaKey)},
+			expectPassphrase: true,
+		},
+		"wrong passphrase": {
+			keys:             auth.SSHKeys{Files: []string{rsaFile}},
+			passphrase:       "wrong",
+			expectPassphrase: true,
+			expectErr:        "couldn't decrypt SSH key " + rsaFile,
+		},
+		"encrypted OpenSSH key": {
+			keys:             auth.SSHKeys{Files: []string{opensshFile}},
+			passphrase:       "secret",
+			expectAuth:       []ssh.PublicKey{opensshKey},
+			expectPassphrase: true,
+		},
+		"wrong OpenSSH passphrase": {
+			keys:             auth.SSHKeys{Files: []string{opensshFile}},
+			passphrase:       "wrong",
+			expectPassphrase: true,
+			expectErr:        "couldn't decrypt SSH key " + opensshFile,
+		},
+		"missing key": {
+			keys:      auth.SSHKeys{Files: []string{missingFile}},
+			expectErr: "couldn't read SSH key",
+		},
+		"no keys": {
+			keys: auth.SSHKeys{
+				Files:       []string{missingFile},
+				SkipMissing: true,
+			},
+			expectErr: "no SSH keys found, tried " + missingFile,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			var asked []string
+			keys := tc.keys
+			if tc.passphrase != "" {
+				keys.Passphrase = func(path string) ([]byte, error) {
+					asked = append(asked, path)
+					return []byte(tc.passphrase), nil
+				}
+			}
+			method, done, err := keys.AuthMethod()
+			if (len(asked) > 0) != tc.expectPassphrase {
+				tt.Fatalf("expected passphrase prompt %v, got %v",
+					tc.expectPassphrase, asked)
+			}
+			if tc.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectErr) {
+					tt.Fatalf("expected error %q, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			defer done()
+			for _, key := range tc.expectAuth {
+				if !authenticates(tt, method, key) {
+					tt.Fatalf("expected authentication with %s",
+						ssh.FingerprintSHA256(key))
+				}
+			}
+			if authenticates(tt, method, publicKey(agentKey)) !=
+				(keys.AgentSocket == agentSocket) {
+				tt.Fatalf("unexpected use of the agent")
+			}
+		})
+	}
+}
+
+func writeKey(t *testing.T, path string, block *pem.Block) {
+	t.Helper()
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(block), 0600); err != nil {
+		t.Fatalf("couldn't write key: %v", err)
+	}
+}
+
+func TestAskPass(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lagoon-cli-askpass")
+	if err != nil {
+		t.Fatalf("couldn't create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	askPass := filepath.Join(dir, "askpass")
+	err = ioutil.WriteFile(askPass,
+		[]byte("#!/bin/sh\necho \"pass for $1\"\n"), 0700)
+	if err != nil {
+		t.Fatalf("couldn't write askpass: %v", err)
+	}
+	passphrase, err := auth.AskPass(askPass, "key")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(passphrase) != "pass for key" {
+		t.Fatalf("expected passphrase %q, got %q", "pass for key", passphrase)
+	}
+}
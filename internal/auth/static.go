@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// Static reads a long-lived token from an environment variable or a file,
+// which is useful in CI where there is no SSH key.
+type Static struct {
+	// Env is the name of an environment variable holding the token.
+	Env string
+	// File is the path to a file holding the token. It is used if Env is
+	// empty or the variable is unset.
+	File string
+}
+
+// Token implements TokenSource.
+func (s *Static) Token(_ context.Context) (string, error) {
+	if s.Env != "" {
+		if token := strings.TrimSpace(os.Getenv(s.Env)); token != "" {
+			return token, nil
+		}
+	}
+	if s.File != "" {
+		data, err := ioutil.ReadFile(s.File)
+		if err != nil {
+			return "", fmt.Errorf("couldn't read token file: %v", err)
+		}
+		if token := strings.TrimSpace(string(data)); token != "" {
+			return token, nil
+		}
+		return "", fmt.Errorf("token file %s is empty", s.File)
+	}
+	if s.Env != "" {
+		return "", fmt.Errorf("environment variable %s is not set", s.Env)
+	}
+	return "", fmt.Errorf("no token-env or token-file configured")
+}
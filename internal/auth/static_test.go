@@ -0,0 +1,72 @@
+package auth_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/auth"
+)
+
+func TestStatic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lagoon-cli-auth")
+	if err != nil {
+		t.Fatalf("couldn't create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	tokenFile := filepath.Join(dir, "token")
+	if err = ioutil.WriteFile(tokenFile, []byte("filetoken\n"), 0600); err != nil {
+		t.Fatalf("couldn't write token file: %v", err)
+	}
+	os.Setenv("LAGOON_CLI_TEST_TOKEN", "envtoken")
+	defer os.Unsetenv("LAGOON_CLI_TEST_TOKEN")
+
+	var testCases = map[string]struct {
+		static      auth.Static
+		expectToken string
+		expectErr   string
+	}{
+		"env": {
+			static:      auth.Static{Env: "LAGOON_CLI_TEST_TOKEN", File: tokenFile},
+			expectToken: "envtoken",
+		},
+		"file fallback": {
+			static:      auth.Static{Env: "LAGOON_CLI_TEST_UNSET", File: tokenFile},
+			expectToken: "filetoken",
+		},
+		"unset env": {
+			static:    auth.Static{Env: "LAGOON_CLI_TEST_UNSET"},
+			expectErr: "environment variable LAGOON_CLI_TEST_UNSET is not set",
+		},
+		"missing file": {
+			static:    auth.Static{File: filepath.Join(dir, "missing")},
+			expectErr: "couldn't read token file",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			token, err := tc.static.Token(context.Background())
+			checkToken(tt, token, err, tc.expectToken, tc.expectErr)
+		})
+	}
+}
+
+func checkToken(t *testing.T, token string, err error, expectToken,
+	expectErr string) {
+	t.Helper()
+	if expectErr != "" {
+		if err == nil || !strings.Contains(err.Error(), expectErr) {
+			t.Fatalf("expected error %q, got %v", expectErr, err)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != expectToken {
+		t.Fatalf("expected token %q, got %q", expectToken, token)
+	}
+}
@@ -0,0 +1,1014 @@
+package lagoon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+)
+
+// Applier interface contains methods for reconciling the state of Lagoon with
+// a configuration object.
+type Applier interface {
+	Importer
+	UserByEmail(context.Context, string, *schema.User) error
+	UpdateProject(
+		context.Context, *schema.UpdateProjectInput, *schema.Project) error
+	UpdateUser(context.Context, *schema.UpdateUserInput, *schema.User) error
+	UpdateNotificationSlack(context.Context,
+		*schema.UpdateNotificationSlackInput,
+		*schema.NotificationSlack) error
+	UpdateNotificationRocketChat(context.Context,
+		*schema.UpdateNotificationRocketChatInput,
+		*schema.NotificationRocketChat) error
+	UpdateNotificationEmail(context.Context,
+		*schema.UpdateNotificationEmailInput,
+		*schema.NotificationEmail) error
+	UpdateNotificationMicrosoftTeams(context.Context,
+		*schema.UpdateNotificationMicrosoftTeamsInput,
+		*schema.NotificationMicrosoftTeams) error
+	DeleteEnvironment(
+		context.Context, *schema.DeleteEnvironmentInput, *string) error
+	DeleteEnvVariable(
+		context.Context, *schema.DeleteEnvVariableInput, *string) error
+	DeleteSSHKey(context.Context, *schema.DeleteSSHKeyInput, *string) error
+	RemoveGroupsFromProject(
+		context.Context, *schema.ProjectGroupsInput, *schema.Project) error
+	RemoveNotificationFromProject(context.Context,
+		*schema.RemoveNotificationFromProjectInput, *schema.Project) error
+	RemoveUserFromGroup(
+		context.Context, *schema.UserGroupInput, *schema.Group) error
+}
+
+// Action is the type of a Change.
+type Action string
+
+// These are the possible Actions of a Change.
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// FieldChange describes the change to a single field of a Lagoon object.
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// Change describes a single change to an object in the Lagoon API.
+// Kind is the type of the object, and Name identifies it. Objects which are
+// attached to other objects are named using a path. e.g. the envVariable FOO
+// of the environment main in the project bananas is named bananas/main/FOO.
+type Change struct {
+	Action Action        `json:"action"`
+	Kind   string        `json:"kind"`
+	Name   string        `json:"name"`
+	Fields []FieldChange `json:"fields,omitempty"`
+	apply  func(context.Context) error
+}
+
+// Plan is an ordered list of the Changes required to bring the Lagoon API in
+// line with a configuration object.
+type Plan struct {
+	Changes []Change `json:"changes"`
+	// errs are problems found during planning which prevent the Plan from
+	// being applied.
+	errs []error
+}
+
+// Validate returns an error if the Plan can't be applied.
+func (p *Plan) Validate() error {
+	if len(p.errs) > 0 {
+		return fmt.Errorf("invalid plan: %w", p.errs[0])
+	}
+	return nil
+}
+
+// Apply makes the planned changes in the Lagoon API.
+func (p *Plan) Apply(ctx context.Context, keepGoing bool) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	l := log.New(os.Stderr, "apply: ", 0)
+	var failed int
+	for _, c := range p.Changes {
+		// don't keep going once the context is done
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := c.apply(ctx); err != nil {
+			if !keepGoing {
+				return fmt.Errorf(
+					`couldn't %s %s "%s": %w`, c.Action, c.Kind, c.Name, err)
+			}
+			l.Printf(`couldn't %s %s "%s": %v`, c.Action, c.Kind, c.Name, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d changes failed", failed, len(p.Changes))
+	}
+	return nil
+}
+
+// Apply reconciles the Lagoon API with a configuration object read from r.
+// See PlanApply for details.
+func Apply(ctx context.Context, a Applier, r io.Reader, prune, keepGoing bool,
+	openshiftID uint) error {
+
+	config, err := ParseConfig(r)
+	if err != nil {
+		return err
+	}
+	plan, err := PlanApply(ctx, a, config, prune, openshiftID)
+	if err != nil {
+		return err
+	}
+	return plan.Apply(ctx, keepGoing)
+}
+
+// PlanApply compares a configuration object with the current state of the
+// Lagoon API, and returns the Plan of changes required to bring Lagoon in line
+// with the configuration. Objects are created or updated as required.
+//
+// If prune is true, objects attached to an object in the configuration which
+// are not themselves in the configuration are removed. e.g. environments,
+// envVariables, groups, users and notifications of a project, members of a
+// group, and SSH keys of a user. Top-level objects which are not in the
+// configuration are never removed.
+//
+// openshiftID is required to create projects, and if it is non-zero it is also
+// used to update the openshift of existing projects. If a project needs to be
+// created and openshiftID is zero, the Plan will fail validation.
+func PlanApply(ctx context.Context, a Applier, config *schema.Config,
+	prune bool, openshiftID uint) (*Plan, error) {
+
+	p := planner{a: a, prune: prune, openshiftID: openshiftID}
+	// query the projects first because notifications are only visible via the
+	// projects they are attached to.
+	projects := map[string]*schema.Project{}
+	for _, project := range config.Projects {
+		current := schema.Project{}
+		if err := a.ProjectByName(ctx, project.Name, &current); err != nil {
+			return nil, fmt.Errorf(
+				`couldn't get project "%s" by name: %w`, project.Name, err)
+		}
+		projects[project.Name] = &current
+	}
+	for _, bg := range config.BillingGroups {
+		if err := p.planBillingGroup(ctx, bg); err != nil {
+			return nil, err
+		}
+	}
+	for _, group := range config.Groups {
+		if err := p.planGroup(ctx, group); err != nil {
+			return nil, err
+		}
+	}
+	for _, user := range config.Users {
+		if err := p.planUser(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+	if config.Notifications != nil {
+		p.planNotifications(config.Notifications, projects)
+	}
+	for _, project := range config.Projects {
+		if err := p.planProject(project, projects[project.Name]); err != nil {
+			return nil, err
+		}
+	}
+	return &p.plan, nil
+}
+
+// planner accumulates a Plan.
+type planner struct {
+	a           Applier
+	prune       bool
+	openshiftID uint
+	plan        Plan
+}
+
+// add appends a Change to the Plan.
+func (p *planner) add(action Action, kind, name string, fields []FieldChange,
+	apply func(context.Context) error) {
+	p.plan.Changes = append(p.plan.Changes, Change{
+		Action: action,
+		Kind:   kind,
+		Name:   name,
+		Fields: fields,
+		apply:  apply,
+	})
+}
+
+// diffField appends a FieldChange to fields if old and new differ.
+func diffField(fields []FieldChange, name string,
+	old, new interface{}) []FieldChange {
+	o, n := fmt.Sprint(old), fmt.Sprint(new)
+	if o == n {
+		return fields
+	}
+	return append(fields, FieldChange{Field: name, Old: o, New: n})
+}
+
+// diffSensitiveField is like diffField, but doesn't reveal the values.
+func diffSensitiveField(fields []FieldChange, name string,
+	old, new string) []FieldChange {
+	if old == new {
+		return fields
+	}
+	fc := FieldChange{Field: name}
+	if old != "" {
+		fc.Old = "(sensitive)"
+	}
+	if new != "" {
+		fc.New = "(sensitive)"
+	}
+	return append(fields, fc)
+}
+
+func (p *planner) planBillingGroup(ctx context.Context,
+	bg schema.AddBillingGroupInput) error {
+	current := schema.Groups{}
+	if err := p.a.GroupByName(ctx, bg.Name, &current); err != nil {
+		return fmt.Errorf(`couldn't get billing group "%s": %w`, bg.Name, err)
+	}
+	for _, c := range current.BillingGroups {
+		if c.Name == bg.Name {
+			// existing billing groups are not updated
+			return nil
+		}
+	}
+	var fields []FieldChange
+	fields = diffField(fields, "currency", "", bg.Currency)
+	fields = diffField(fields, "billingSoftware", "", bg.BillingSoftware)
+	p.add(Create, "billingGroup", bg.Name, fields,
+		func(ctx context.Context) error {
+			return p.a.AddBillingGroup(ctx, &bg, nil)
+		})
+	return nil
+}
+
+func (p *planner) planGroup(ctx context.Context, group schema.GroupConfig) error {
+	current := schema.Groups{}
+	if err := p.a.GroupByName(ctx, group.Name, &current); err != nil {
+		return fmt.Errorf(`couldn't get group "%s": %w`, group.Name, err)
+	}
+	var members []schema.UserRoleConfig
+	exists := false
+	for _, c := range current.Groups {
+		if c.Name != group.Name {
+			continue
+		}
+		exists = true
+		for _, m := range c.Members {
+			members = append(members,
+				schema.UserRoleConfig{Email: m.User.Email, Role: m.Role})
+		}
+	}
+	if !exists {
+		p.add(Create, "group", group.Name, nil,
+			func(ctx context.Context) error {
+				return p.a.AddGroup(ctx, &group.AddGroupInput, nil)
+			})
+	}
+	p.planMembers("groupMember", group.Name, group.Name, group.Users, members)
+	return nil
+}
+
+// planMembers plans the changes required to make the members of the given
+// group match want. Change names are prefixed with prefix.
+func (p *planner) planMembers(kind, prefix, groupName string,
+	want, have []schema.UserRoleConfig) {
+	haveRoles := map[string]api.GroupRole{}
+	for _, m := range have {
+		haveRoles[m.Email] = m.Role
+	}
+	wantRoles := map[string]bool{}
+	for _, m := range want {
+		m := m
+		wantRoles[m.Email] = true
+		role, ok := haveRoles[m.Email]
+		if ok && role == m.Role {
+			continue
+		}
+		action := Create
+		if ok {
+			action = Update
+		}
+		// addUserToGroup also updates the role of existing members
+		p.add(action, kind, prefix+"/"+m.Email,
+			diffField(nil, "role", role, m.Role),
+			func(ctx context.Context) error {
+				return p.a.AddUserToGroup(ctx, &schema.UserGroupRoleInput{
+					UserEmail: m.Email,
+					GroupName: groupName,
+					GroupRole: m.Role,
+				}, nil)
+			})
+	}
+	if !p.prune {
+		return
+	}
+	for _, m := range have {
+		m := m
+		if wantRoles[m.Email] {
+			continue
+		}
+		p.add(Delete, kind, prefix+"/"+m.Email, nil,
+			func(ctx context.Context) error {
+				return p.a.RemoveUserFromGroup(ctx, &schema.UserGroupInput{
+					UserEmail: m.Email,
+					GroupName: groupName,
+				}, nil)
+			})
+	}
+}
+
+func (p *planner) planUser(ctx context.Context, user schema.User) error {
+	current := schema.User{}
+	if err := p.a.UserByEmail(ctx, user.Email, &current); err != nil {
+		return fmt.Errorf(`couldn't get user "%s": %w`, user.Email, err)
+	}
+	var fields []FieldChange
+	if current.Email == "" {
+		fields = diffField(fields, "firstName", "", user.FirstName)
+		fields = diffField(fields, "lastName", "", user.LastName)
+		fields = diffField(fields, "comment", "", user.Comment)
+		fields = diffField(fields, "gitlabId", uint(0), user.GitlabID)
+		p.add(Create, "user", user.Email, fields,
+			func(ctx context.Context) error {
+				return p.a.AddUser(ctx, &user.AddUserInput, nil)
+			})
+	} else {
+		// only fields which are set in the config are managed
+		patch := schema.UpdateUserPatchInput{}
+		if user.FirstName != "" && user.FirstName != current.FirstName {
+			patch.FirstName = user.FirstName
+			fields = diffField(fields, "firstName", current.FirstName, user.FirstName)
+		}
+		if user.LastName != "" && user.LastName != current.LastName {
+			patch.LastName = user.LastName
+			fields = diffField(fields, "lastName", current.LastName, user.LastName)
+		}
+		if user.Comment != "" && user.Comment != current.Comment {
+			patch.Comment = user.Comment
+			fields = diffField(fields, "comment", current.Comment, user.Comment)
+		}
+		if user.GitlabID != 0 && user.GitlabID != current.GitlabID {
+			patch.GitlabID = user.GitlabID
+			fields = diffField(fields, "gitlabId", current.GitlabID, user.GitlabID)
+		}
+		if len(fields) > 0 {
+			p.add(Update, "user", user.Email, fields,
+				func(ctx context.Context) error {
+					return p.a.UpdateUser(ctx, &schema.UpdateUserInput{
+						User:  schema.UserInput{Email: user.Email},
+						Patch: patch,
+					}, nil)
+				})
+		}
+	}
+	// SSH keys are identified by name
+	haveKeys := map[string]schema.SSHKey{}
+	for _, k := range current.SSHKeys {
+		haveKeys[k.Name] = k
+	}
+	wantKeys := map[string]bool{}
+	for _, k := range user.SSHKeys {
+		k := k
+		wantKeys[k.Name] = true
+		add := func(ctx context.Context) error {
+			return p.a.AddSSHKey(ctx, &schema.AddSSHKeyInput{
+				SSHKey:    k,
+				UserEmail: user.Email,
+			}, nil)
+		}
+		old, ok := haveKeys[k.Name]
+		switch {
+		case !ok:
+			p.add(Create, "sshKey", user.Email+"/"+k.Name,
+				diffField(nil, "keyType", "", k.KeyType), add)
+		case old != k:
+			// SSH keys can't be updated, so replace the key
+			var fields []FieldChange
+			fields = diffField(fields, "keyType", old.KeyType, k.KeyType)
+			fields = diffField(fields, "keyValue", old.KeyValue, k.KeyValue)
+			p.add(Update, "sshKey", user.Email+"/"+k.Name, fields,
+				func(ctx context.Context) error {
+					err := p.a.DeleteSSHKey(ctx,
+						&schema.DeleteSSHKeyInput{Name: k.Name}, nil)
+					if err != nil {
+						return err
+					}
+					return add(ctx)
+				})
+		}
+	}
+	if !p.prune {
+		return nil
+	}
+	for _, k := range current.SSHKeys {
+		k := k
+		if wantKeys[k.Name] {
+			continue
+		}
+		p.add(Delete, "sshKey", user.Email+"/"+k.Name, nil,
+			func(ctx context.Context) error {
+				return p.a.DeleteSSHKey(ctx,
+					&schema.DeleteSSHKeyInput{Name: k.Name}, nil)
+			})
+	}
+	return nil
+}
+
+// planNotifications plans the changes to top-level notifications. The
+// current state of a notification is only known if it is attached to one of
+// the given projects, so notifications which aren't known are created, and
+// updated instead if they already exist.
+func (p *planner) planNotifications(n *schema.NotificationsConfig,
+	projects map[string]*schema.Project) {
+	slack := map[string]schema.AddNotificationSlackInput{}
+	rocketChat := map[string]schema.AddNotificationRocketChatInput{}
+	email := map[string]schema.AddNotificationEmailInput{}
+	microsoftTeams := map[string]schema.AddNotificationMicrosoftTeamsInput{}
+	for _, project := range projects {
+		if project.Notifications == nil {
+			continue
+		}
+		for _, c := range project.Notifications.Slack {
+			slack[c.Name] = c
+		}
+		for _, c := range project.Notifications.RocketChat {
+			rocketChat[c.Name] = c
+		}
+		for _, c := range project.Notifications.Email {
+			email[c.Name] = c
+		}
+		for _, c := range project.Notifications.MicrosoftTeams {
+			microsoftTeams[c.Name] = c
+		}
+	}
+	for _, want := range n.Slack {
+		want := want
+		have, ok := slack[want.Name]
+		if ok && have == want {
+			continue
+		}
+		var fields []FieldChange
+		fields = diffSensitiveField(fields, "webhook", have.Webhook, want.Webhook)
+		fields = diffField(fields, "channel", have.Channel, want.Channel)
+		update := func(ctx context.Context) error {
+			return p.a.UpdateNotificationSlack(ctx,
+				&schema.UpdateNotificationSlackInput{
+					Name:  want.Name,
+					Patch: schema.UpdateNotificationSlackPatchInput(want),
+				}, nil)
+		}
+		if ok {
+			p.add(Update, "notificationSlack", want.Name, fields, update)
+			continue
+		}
+		p.add(Create, "notificationSlack", want.Name, fields,
+			func(ctx context.Context) error {
+				err := p.a.AddNotificationSlack(ctx, &want, nil)
+				if errors.Is(err, ErrExist) {
+					return update(ctx)
+				}
+				return err
+			})
+	}
+	for _, want := range n.RocketChat {
+		want := want
+		have, ok := rocketChat[want.Name]
+		if ok && have == want {
+			continue
+		}
+		var fields []FieldChange
+		fields = diffSensitiveField(fields, "webhook", have.Webhook, want.Webhook)
+		fields = diffField(fields, "channel", have.Channel, want.Channel)
+		update := func(ctx context.Context) error {
+			return p.a.UpdateNotificationRocketChat(ctx,
+				&schema.UpdateNotificationRocketChatInput{
+					Name:  want.Name,
+					Patch: schema.UpdateNotificationRocketChatPatchInput(want),
+				}, nil)
+		}
+		if ok {
+			p.add(Update, "notificationRocketChat", want.Name, fields, update)
+			continue
+		}
+		p.add(Create, "notificationRocketChat", want.Name, fields,
+			func(ctx context.Context) error {
+				err := p.a.AddNotificationRocketChat(ctx, &want, nil)
+				if errors.Is(err, ErrExist) {
+					return update(ctx)
+				}
+				return err
+			})
+	}
+	for _, want := range n.Email {
+		want := want
+		have, ok := email[want.Name]
+		if ok && have == want {
+			continue
+		}
+		fields := diffField(nil, "emailAddress",
+			have.EmailAddress, want.EmailAddress)
+		update := func(ctx context.Context) error {
+			return p.a.UpdateNotificationEmail(ctx,
+				&schema.UpdateNotificationEmailInput{Name: want.Name, Patch: want}, nil)
+		}
+		if ok {
+			p.add(Update, "notificationEmail", want.Name, fields, update)
+			continue
+		}
+		p.add(Create, "notificationEmail", want.Name, fields,
+			func(ctx context.Context) error {
+				err := p.a.AddNotificationEmail(ctx, &want, nil)
+				if errors.Is(err, ErrExist) {
+					return update(ctx)
+				}
+				return err
+			})
+	}
+	for _, want := range n.MicrosoftTeams {
+		want := want
+		have, ok := microsoftTeams[want.Name]
+		if ok && have == want {
+			continue
+		}
+		fields := diffSensitiveField(nil, "webhook", have.Webhook, want.Webhook)
+		update := func(ctx context.Context) error {
+			return p.a.UpdateNotificationMicrosoftTeams(ctx,
+				&schema.UpdateNotificationMicrosoftTeamsInput{
+					Name: want.Name, Patch: want}, nil)
+		}
+		if ok {
+			p.add(Update, "notificationMicrosoftTeams", want.Name, fields, update)
+			continue
+		}
+		p.add(Create, "notificationMicrosoftTeams", want.Name, fields,
+			func(ctx context.Context) error {
+				err := p.a.AddNotificationMicrosoftTeams(ctx, &want, nil)
+				if errors.Is(err, ErrExist) {
+					return update(ctx)
+				}
+				return err
+			})
+	}
+}
+
+// errNoParent is returned when applying a Change to an object whose parent
+// wasn't created.
+var errNoParent = errors.New("parent object doesn't exist")
+
+func (p *planner) planProject(want schema.ProjectConfig,
+	current *schema.Project) error {
+	// project is populated when the project is created
+	project := &schema.Project{}
+	if current.ID == 0 {
+		if p.openshiftID == 0 {
+			p.plan.errs = append(p.plan.errs, fmt.Errorf(
+				`project "%s" doesn't exist and no openshiftID was given`, want.Name))
+		}
+		in := want.AddProjectInput
+		in.Openshift = p.openshiftID
+		p.add(Create, "project", want.Name, projectFields(&in),
+			func(ctx context.Context) error {
+				return p.a.AddProject(ctx, &in, project)
+			})
+		// everything attached to the project needs to be created
+		current = &schema.Project{}
+	} else {
+		project.ID = current.ID
+		patch, fields := projectPatch(want.AddProjectInput, current, p.openshiftID)
+		if len(fields) > 0 {
+			p.add(Update, "project", want.Name, fields,
+				func(ctx context.Context) error {
+					return p.a.UpdateProject(ctx, &schema.UpdateProjectInput{
+						ID:    current.ID,
+						Patch: patch,
+					}, nil)
+				})
+		}
+	}
+	projectID := func() uint { return project.ID }
+	p.planEnvVariables(want.Name, api.ProjectVar, projectID,
+		want.EnvVariables, current.EnvVariables)
+	p.planEnvironments(want.Name, projectID, want.Environments,
+		current.Environments)
+	// split the current groups into regular groups and project group members
+	var groups, billingGroups []string
+	var members []schema.UserRoleConfig
+	projectGroup := fmt.Sprintf("project-%s", want.Name)
+	if current.Groups != nil {
+		for _, g := range current.Groups.Groups {
+			if g.Name != projectGroup {
+				groups = append(groups, g.Name)
+				continue
+			}
+			for _, m := range g.Members {
+				// skip default users, these are created by Lagoon automatically
+				if fmt.Sprintf("default-user@%s", want.Name) == m.User.Email {
+					continue
+				}
+				members = append(members,
+					schema.UserRoleConfig{Email: m.User.Email, Role: m.Role})
+			}
+		}
+		for _, bg := range current.Groups.BillingGroups {
+			billingGroups = append(billingGroups, bg.Name)
+		}
+	}
+	p.planProjectGroups(want.Name, want.Groups, groups)
+	if len(want.BillingGroups) > 1 {
+		return fmt.Errorf(
+			`project can only have one billing group: %v`, want.BillingGroups)
+	}
+	for _, bgName := range want.BillingGroups {
+		bgName := bgName
+		if contains(billingGroups, bgName) {
+			continue
+		}
+		p.add(Create, "projectBillingGroup", want.Name+"/"+bgName, nil,
+			func(ctx context.Context) error {
+				return p.a.AddProjectToBillingGroup(ctx,
+					&schema.ProjectBillingGroupInput{
+						Group:   schema.GroupInput{Name: bgName},
+						Project: schema.ProjectInput{Name: want.Name},
+					}, nil)
+			})
+	}
+	p.planMembers("projectUser", want.Name, projectGroup, want.Users, members)
+	p.planProjectNotifications(want.Name, want.Notifications,
+		current.Notifications)
+	return nil
+}
+
+// projectFields returns the FieldChanges for creation of a project.
+func projectFields(in *schema.AddProjectInput) []FieldChange {
+	var fields []FieldChange
+	fields = diffField(fields, "gitUrl", "", in.GitURL)
+	fields = diffField(fields, "subfolder", "", in.Subfolder)
+	fields = diffField(fields, "openshift", uint(0), in.Openshift)
+	fields = diffField(fields, "openshiftProjectPattern", "",
+		in.OpenshiftProjectPattern)
+	fields = diffField(fields, "activeSystemsDeploy", "", in.ActiveSystemsDeploy)
+	fields = diffField(fields, "activeSystemsPromote", "",
+		in.ActiveSystemsPromote)
+	fields = diffField(fields, "activeSystemsRemove", "", in.ActiveSystemsRemove)
+	fields = diffField(fields, "activeSystemsTask", "", in.ActiveSystemsTask)
+	fields = diffField(fields, "branches", "", in.Branches)
+	fields = diffField(fields, "pullrequests", "", in.PullRequests)
+	fields = diffField(fields, "productionEnvironment", "",
+		in.ProductionEnvironment)
+	fields = diffField(fields, "availability", "", in.Availability)
+	fields = diffField(fields, "autoIdle", uint(0), in.AutoIdle)
+	fields = diffField(fields, "storageCalc", uint(0), in.StorageCalc)
+	fields = diffField(fields, "developmentEnvironmentsLimit", uint(0),
+		in.DevelopmentEnvironmentsLimit)
+	return diffSensitiveField(fields, "privateKey", "", in.PrivateKey)
+}
+
+// projectPatch compares the wanted and current state of a project and returns
+// the patch and FieldChanges required to update it. Fields which are empty in
+// want are either set to the Lagoon default value, or are not managed.
+func projectPatch(want schema.AddProjectInput, current *schema.Project,
+	openshiftID uint) (schema.UpdateProjectPatchInput, []FieldChange) {
+	var patch schema.UpdateProjectPatchInput
+	var fields []FieldChange
+	defaults := schema.ProjectDefaults()
+	str := func(name, want, fallback, have string, set *string) {
+		if want == "" {
+			want = fallback
+		}
+		if want == "" || want == have {
+			return
+		}
+		*set = want
+		fields = diffField(fields, name, have, want)
+	}
+	str("gitUrl", want.GitURL, "", current.GitURL, &patch.GitURL)
+	str("subfolder", want.Subfolder, "", current.Subfolder, &patch.Subfolder)
+	str("openshiftProjectPattern", want.OpenshiftProjectPattern, "",
+		current.OpenshiftProjectPattern, &patch.OpenshiftProjectPattern)
+	str("activeSystemsDeploy", want.ActiveSystemsDeploy,
+		defaults.ActiveSystemsDeploy, current.ActiveSystemsDeploy,
+		&patch.ActiveSystemsDeploy)
+	str("activeSystemsPromote", want.ActiveSystemsPromote,
+		defaults.ActiveSystemsPromote, current.ActiveSystemsPromote,
+		&patch.ActiveSystemsPromote)
+	str("activeSystemsRemove", want.ActiveSystemsRemove,
+		defaults.ActiveSystemsRemove, current.ActiveSystemsRemove,
+		&patch.ActiveSystemsRemove)
+	str("activeSystemsTask", want.ActiveSystemsTask,
+		defaults.ActiveSystemsTask, current.ActiveSystemsTask,
+		&patch.ActiveSystemsTask)
+	str("branches", want.Branches, defaults.Branches, current.Branches,
+		&patch.Branches)
+	str("pullrequests", want.PullRequests, defaults.PullRequests,
+		current.PullRequests, &patch.PullRequests)
+	str("productionEnvironment", want.ProductionEnvironment, "",
+		current.ProductionEnvironment, &patch.ProductionEnvironment)
+	if want.Availability != "" && want.Availability != current.Availability {
+		patch.Availability = want.Availability
+		fields = diffField(fields, "availability",
+			current.Availability, want.Availability)
+	}
+	if want.AutoIdle != current.AutoIdle {
+		patch.AutoIdle = &want.AutoIdle
+		fields = diffField(fields, "autoIdle", current.AutoIdle, want.AutoIdle)
+	}
+	if want.StorageCalc != current.StorageCalc {
+		patch.StorageCalc = &want.StorageCalc
+		fields = diffField(fields, "storageCalc",
+			current.StorageCalc, want.StorageCalc)
+	}
+	limit := want.DevelopmentEnvironmentsLimit
+	if limit == 0 {
+		limit = defaults.DevelopmentEnvironmentsLimit
+	}
+	if limit != current.DevelopmentEnvironmentsLimit {
+		patch.DevelopmentEnvironmentsLimit = limit
+		fields = diffField(fields, "developmentEnvironmentsLimit",
+			current.DevelopmentEnvironmentsLimit, limit)
+	}
+	if want.PrivateKey != "" && want.PrivateKey != current.PrivateKey {
+		patch.PrivateKey = want.PrivateKey
+		fields = diffSensitiveField(fields, "privateKey",
+			current.PrivateKey, want.PrivateKey)
+	}
+	if openshiftID != 0 && current.OpenshiftID != nil &&
+		openshiftID != current.OpenshiftID.ID {
+		patch.Openshift = openshiftID
+		fields = diffField(fields, "openshift", current.OpenshiftID.ID, openshiftID)
+	}
+	return patch, fields
+}
+
+// planEnvVariables plans the changes required to make the envVariables of a
+// project or environment match want. typeID is called during apply to get the
+// ID of the project or environment.
+func (p *planner) planEnvVariables(prefix string, t api.EnvVariableType,
+	typeID func() uint, want, have []schema.EnvKeyValue) {
+	haveVars := map[string]schema.EnvKeyValue{}
+	for _, ev := range have {
+		haveVars[ev.Name] = ev
+	}
+	wantVars := map[string]bool{}
+	for _, ev := range want {
+		ev := ev
+		ev.ID = 0
+		wantVars[ev.Name] = true
+		add := func(ctx context.Context) error {
+			if typeID() == 0 {
+				return errNoParent
+			}
+			return p.a.AddEnvVariable(ctx, &schema.EnvVariableInput{
+				EnvKeyValue: ev,
+				Type:        t,
+				TypeID:      typeID(),
+			}, nil)
+		}
+		old, ok := haveVars[ev.Name]
+		var fields []FieldChange
+		fields = diffField(fields, "scope", old.Scope, ev.Scope)
+		fields = diffSensitiveField(fields, "value", old.Value, ev.Value)
+		switch {
+		case !ok:
+			p.add(Create, "envVariable", prefix+"/"+ev.Name, fields, add)
+		case len(fields) > 0:
+			// envVariables can't be updated, so replace the variable
+			p.add(Update, "envVariable", prefix+"/"+ev.Name, fields,
+				func(ctx context.Context) error {
+					err := p.a.DeleteEnvVariable(ctx,
+						&schema.DeleteEnvVariableInput{ID: old.ID}, nil)
+					if err != nil {
+						return err
+					}
+					return add(ctx)
+				})
+		}
+	}
+	if !p.prune {
+		return
+	}
+	for _, ev := range have {
+		ev := ev
+		if wantVars[ev.Name] {
+			continue
+		}
+		p.add(Delete, "envVariable", prefix+"/"+ev.Name, nil,
+			func(ctx context.Context) error {
+				return p.a.DeleteEnvVariable(ctx,
+					&schema.DeleteEnvVariableInput{ID: ev.ID}, nil)
+			})
+	}
+}
+
+// planEnvironments plans the changes required to make the environments of a
+// project match want.
+func (p *planner) planEnvironments(projectName string, projectID func() uint,
+	want, have []schema.EnvironmentConfig) {
+	haveEnvs := map[string]schema.EnvironmentConfig{}
+	for _, env := range have {
+		haveEnvs[env.Name] = env
+	}
+	wantEnvs := map[string]bool{}
+	for _, env := range want {
+		env := env
+		wantEnvs[env.Name] = true
+		// environment is populated when the environment is created
+		environment := &schema.Environment{}
+		old, ok := haveEnvs[env.Name]
+		environment.ID = old.ID
+		// only fields which are set in the config are managed
+		var fields []FieldChange
+		if env.DeployType != "" {
+			fields = diffField(fields, "deployType", old.DeployType, env.DeployType)
+		}
+		if env.DeployBaseRef != "" {
+			fields = diffField(fields, "deployBaseRef",
+				old.DeployBaseRef, env.DeployBaseRef)
+		}
+		if env.DeployHeadRef != "" {
+			fields = diffField(fields, "deployHeadRef",
+				old.DeployHeadRef, env.DeployHeadRef)
+		}
+		if env.DeployTitle != "" {
+			fields = diffField(fields, "deployTitle", old.DeployTitle, env.DeployTitle)
+		}
+		if env.EnvironmentType != "" {
+			fields = diffField(fields, "environmentType",
+				old.EnvironmentType, env.EnvironmentType)
+		}
+		if env.OpenshiftProjectName != "" {
+			fields = diffField(fields, "openshiftProjectName",
+				old.OpenshiftProjectName, env.OpenshiftProjectName)
+		}
+		if !ok || len(fields) > 0 {
+			action := Create
+			if ok {
+				action = Update
+			}
+			p.add(action, "environment", projectName+"/"+env.Name, fields,
+				func(ctx context.Context) error {
+					if projectID() == 0 {
+						return errNoParent
+					}
+					in := env.Environment.AddEnvironmentInput
+					in.ID = 0
+					in.ProjectID = projectID()
+					err := p.a.AddOrUpdateEnvironment(ctx, &in, environment)
+					if errors.Is(err, ErrExist) {
+						return p.a.EnvironmentByName(
+							ctx, env.Name, projectID(), environment)
+					}
+					return err
+				})
+		}
+		p.planEnvVariables(projectName+"/"+env.Name, api.EnvironmentVar,
+			func() uint { return environment.ID },
+			env.EnvVariables, old.EnvVariables)
+	}
+	if !p.prune {
+		return
+	}
+	for _, env := range have {
+		env := env
+		if wantEnvs[env.Name] {
+			continue
+		}
+		p.add(Delete, "environment", projectName+"/"+env.Name, nil,
+			func(ctx context.Context) error {
+				return p.a.DeleteEnvironment(ctx, &schema.DeleteEnvironmentInput{
+					Name:    env.Name,
+					Project: projectName,
+					Execute: true,
+				}, nil)
+			})
+	}
+}
+
+// planProjectGroups plans the changes required to make the groups of a
+// project match want.
+func (p *planner) planProjectGroups(projectName string, want, have []string) {
+	groupsInput := func(name string) *schema.ProjectGroupsInput {
+		return &schema.ProjectGroupsInput{
+			Project: schema.ProjectInput{Name: projectName},
+			Groups:  []schema.GroupInput{{Name: name}},
+		}
+	}
+	for _, name := range want {
+		name := name
+		if contains(have, name) {
+			continue
+		}
+		p.add(Create, "projectGroup", projectName+"/"+name, nil,
+			func(ctx context.Context) error {
+				return p.a.AddGroupsToProject(ctx, groupsInput(name), nil)
+			})
+	}
+	if !p.prune {
+		return
+	}
+	for _, name := range have {
+		name := name
+		if contains(want, name) {
+			continue
+		}
+		p.add(Delete, "projectGroup", projectName+"/"+name, nil,
+			func(ctx context.Context) error {
+				return p.a.RemoveGroupsFromProject(ctx, groupsInput(name), nil)
+			})
+	}
+}
+
+// planProjectNotifications plans the changes required to make the
+// notifications attached to a project match want.
+func (p *planner) planProjectNotifications(projectName string,
+	want *schema.ProjectNotifications, have *schema.Notifications) {
+	if want == nil {
+		want = &schema.ProjectNotifications{}
+	}
+	if have == nil {
+		have = &schema.Notifications{}
+	}
+	var slack, rocketChat, email, microsoftTeams []string
+	for _, n := range have.Slack {
+		slack = append(slack, n.Name)
+	}
+	for _, n := range have.RocketChat {
+		rocketChat = append(rocketChat, n.Name)
+	}
+	for _, n := range have.Email {
+		email = append(email, n.Name)
+	}
+	for _, n := range have.MicrosoftTeams {
+		microsoftTeams = append(microsoftTeams, n.Name)
+	}
+	p.planProjectNotificationType(projectName, api.SlackNotification,
+		want.Slack, slack)
+	p.planProjectNotificationType(projectName, api.RocketChatNotification,
+		want.RocketChat, rocketChat)
+	p.planProjectNotificationType(projectName, api.EmailNotification,
+		want.Email, email)
+	p.planProjectNotificationType(projectName, api.MicrosoftTeamsNotification,
+		want.MicrosoftTeams, microsoftTeams)
+}
+
+func (p *planner) planProjectNotificationType(projectName string,
+	t api.NotificationType, want, have []string) {
+	input := func(name string) *schema.AddNotificationToProjectInput {
+		return &schema.AddNotificationToProjectInput{
+			Project:          projectName,
+			NotificationType: t,
+			NotificationName: name,
+		}
+	}
+	for _, name := range want {
+		name := name
+		if contains(have, name) {
+			continue
+		}
+		p.add(Create, "projectNotification",
+			fmt.Sprintf("%s/%s/%s", projectName, strings.ToLower(string(t)), name), nil,
+			func(ctx context.Context) error {
+				return p.a.AddNotificationToProject(ctx, input(name), nil)
+			})
+	}
+	if !p.prune {
+		return
+	}
+	for _, name := range have {
+		name := name
+		if contains(want, name) {
+			continue
+		}
+		p.add(Delete, "projectNotification",
+			fmt.Sprintf("%s/%s/%s", projectName, strings.ToLower(string(t)), name), nil,
+			func(ctx context.Context) error {
+				in := schema.RemoveNotificationFromProjectInput(*input(name))
+				return p.a.RemoveNotificationFromProject(ctx, &in, nil)
+			})
+	}
+}
+
+// contains returns true if s contains v.
+func contains(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
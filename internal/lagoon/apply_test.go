@@ -0,0 +1,172 @@
+//go:generate mockgen -source=apply.go -destination=../mock/mock_applier.go -package=mock -aux_files=github.com/amazeeio/lagoon-cli/internal/lagoon=import.go
+
+package lagoon_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/mock"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+	"github.com/golang/mock/gomock"
+)
+
+// currentBananas returns the current state of the bananas project in
+// testdata/apply.yaml.
+func currentBananas() schema.Project {
+	defaults := schema.ProjectDefaults()
+	project := schema.Project{
+		AddProjectInput: defaults.AddProjectInput,
+		EnvVariables: []schema.EnvKeyValue{
+			{ID: 3, Name: "FOO", Scope: api.BuildVar, Value: "baz"},
+			{ID: 4, Name: "OLD", Scope: api.RuntimeVar, Value: "old"},
+		},
+		Environments: []schema.EnvironmentConfig{
+			{Environment: schema.Environment{
+				AddEnvironmentInput: schema.AddEnvironmentInput{
+					ID:                   8,
+					Name:                 "master",
+					DeployType:           api.Branch,
+					DeployBaseRef:        "master",
+					EnvironmentType:      api.ProductionEnv,
+					OpenshiftProjectName: "bananas-master",
+				}}},
+		},
+		Notifications: &schema.Notifications{
+			Slack: []schema.AddNotificationSlackInput{{
+				Name:    "example-slack",
+				Webhook: "https://example.com/hook",
+				Channel: "bananas",
+			}},
+		},
+		Groups: &schema.Groups{
+			Groups: []schema.Group{
+				{AddGroupInput: schema.AddGroupInput{Name: "abc"}},
+				{AddGroupInput: schema.AddGroupInput{Name: "project-bananas"}},
+			},
+		},
+	}
+	project.ID = 7
+	project.Name = "bananas"
+	project.GitURL = "git@github.com:amazeeio/bananas.git"
+	project.ProductionEnvironment = "master"
+	project.StorageCalc = 1
+	return project
+}
+
+func TestApply(t *testing.T) {
+	autoIdle := uint(1)
+	var testCases = map[string]struct {
+		input  string
+		prune  bool
+		expect []string
+	}{
+		"noPrune": {
+			input: "testdata/apply.yaml",
+			expect: []string{
+				`update project "bananas"`,
+				`update envVariable "bananas/FOO"`,
+			},
+		},
+		"prune": {
+			input: "testdata/apply.yaml",
+			prune: true,
+			expect: []string{
+				`update project "bananas"`,
+				`update envVariable "bananas/FOO"`,
+				`delete envVariable "bananas/OLD"`,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			ctx := context.Background()
+			// set up the mock applier
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+			applier := mock.NewMockApplier(ctrl)
+			applier.EXPECT().ProjectByName(ctx, "bananas", gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, project *schema.Project) error {
+					*project = currentBananas()
+					return nil
+				})
+			// read the config
+			file, err := os.Open(tc.input)
+			if err != nil {
+				tt.Fatalf("couldn't open file: %v", err)
+			}
+			defer file.Close()
+			config, err := lagoon.ParseConfig(file)
+			if err != nil {
+				tt.Fatalf("couldn't parse config: %v", err)
+			}
+			// check the plan
+			plan, err := lagoon.PlanApply(ctx, applier, config, tc.prune, 0)
+			if err != nil {
+				tt.Fatalf("couldn't plan: %v", err)
+			}
+			var changes []string
+			for _, c := range plan.Changes {
+				changes = append(changes,
+					fmt.Sprintf(`%s %s "%s"`, c.Action, c.Kind, c.Name))
+			}
+			if !reflect.DeepEqual(changes, tc.expect) {
+				tt.Fatalf("expected changes %v, got %v", tc.expect, changes)
+			}
+			// check the applied changes
+			gomock.InOrder(
+				applier.EXPECT().UpdateProject(ctx, &schema.UpdateProjectInput{
+					ID:    7,
+					Patch: schema.UpdateProjectPatchInput{AutoIdle: &autoIdle},
+				}, nil),
+				applier.EXPECT().DeleteEnvVariable(ctx,
+					&schema.DeleteEnvVariableInput{ID: 3}, nil),
+				applier.EXPECT().AddEnvVariable(ctx, &schema.EnvVariableInput{
+					EnvKeyValue: schema.EnvKeyValue{
+						Name:  "FOO",
+						Scope: api.BuildVar,
+						Value: "bar",
+					},
+					Type:   api.ProjectVar,
+					TypeID: 7,
+				}, nil),
+			)
+			if tc.prune {
+				applier.EXPECT().DeleteEnvVariable(ctx,
+					&schema.DeleteEnvVariableInput{ID: 4}, nil)
+			}
+			if err = plan.Apply(ctx, false); err != nil {
+				tt.Fatalf("couldn't apply: %v", err)
+			}
+		})
+	}
+}
+
+func TestPlanApplyNewProject(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	applier := mock.NewMockApplier(ctrl)
+	applier.EXPECT().ProjectByName(ctx, "bananas", gomock.Any())
+	config := schema.Config{Projects: []schema.ProjectConfig{{}}}
+	config.Projects[0].Name = "bananas"
+	// creating a project requires an openshiftID
+	plan, err := lagoon.PlanApply(ctx, applier, &config, false, 0)
+	if err != nil {
+		t.Fatalf("couldn't plan: %v", err)
+	}
+	if len(plan.Changes) != 1 || plan.Changes[0].Action != lagoon.Create {
+		t.Fatalf("expected a single create change, got %v", plan.Changes)
+	}
+	if err = plan.Validate(); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+	if err = plan.Apply(ctx, true); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
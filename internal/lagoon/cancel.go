@@ -0,0 +1,130 @@
+package lagoon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+// DeploymentCanceller interface contains methods for cancelling the
+// deployments of environments.
+type DeploymentCanceller interface {
+	Deployments
+	CancelDeployment(ctx context.Context, in *schema.CancelDeploymentInput,
+		out *string) error
+}
+
+// TaskCanceller interface contains methods for cancelling the tasks of
+// environments.
+type TaskCanceller interface {
+	ProjectInfo
+	TasksByEnvironment(ctx context.Context, name string, projectID uint,
+		tasks *[]schema.Task) error
+	CancelTask(ctx context.Context, in *schema.CancelTaskInput,
+		out *string) error
+}
+
+// Selector selects a deployment or task of an environment by its ID, name or
+// remote ID. Only the fields which are set are compared.
+type Selector struct {
+	ID       uint
+	Name     string
+	RemoteID string
+}
+
+// String implements fmt.Stringer.
+func (s Selector) String() string {
+	switch {
+	case s.Name != "":
+		return s.Name
+	case s.RemoteID != "":
+		return s.RemoteID
+	}
+	return fmt.Sprintf("%d", s.ID)
+}
+
+// matches returns true if the given ID, name and remote ID match all the
+// fields of the selector which are set.
+func (s Selector) matches(id uint, name, remoteID string) bool {
+	return (s.ID == 0 || s.ID == id) &&
+		(s.Name == "" || s.Name == name) &&
+		(s.RemoteID == "" || s.RemoteID == remoteID)
+}
+
+// SelectDeployment gets the deployment of an environment selected by s.
+func SelectDeployment(ctx context.Context, d Deployments,
+	projectName, environmentName string,
+	s Selector) (*schema.Deployment, error) {
+	deployments, err := GetDeployments(ctx, d, projectName, environmentName)
+	if err != nil {
+		return nil, err
+	}
+	for i, deployment := range deployments {
+		if s.matches(deployment.ID, deployment.Name, deployment.RemoteID) {
+			return &deployments[i], nil
+		}
+	}
+	return nil, fmt.Errorf("deployment %s of environment %s: %w", s,
+		environmentName, ErrNotFound)
+}
+
+// CancelDeployment cancels the deployment of an environment selected by s,
+// and returns the deployment in its state after it was cancelled.
+func CancelDeployment(ctx context.Context, d DeploymentCanceller,
+	projectName, environmentName string,
+	s Selector) (*schema.Deployment, error) {
+	deployment, err := SelectDeployment(ctx, d, projectName, environmentName, s)
+	if err != nil {
+		return nil, err
+	}
+	var result string
+	err = d.CancelDeployment(ctx, &schema.CancelDeploymentInput{
+		Deployment: schema.DeploymentInput{ID: deployment.ID},
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return SelectDeployment(ctx, d, projectName, environmentName,
+		Selector{ID: deployment.ID})
+}
+
+// SelectTask gets the task of an environment selected by s.
+func SelectTask(ctx context.Context, t TaskCanceller,
+	projectName, environmentName string, s Selector) (*schema.Task, error) {
+	project, err := GetProjectInfo(ctx, t, projectName)
+	if err != nil {
+		return nil, err
+	}
+	tasks := []schema.Task{}
+	err = t.TasksByEnvironment(ctx, environmentName, project.ID, &tasks)
+	if err != nil {
+		return nil, err
+	}
+	for i, task := range tasks {
+		if s.matches(task.ID, task.Name, task.RemoteID) {
+			return &tasks[i], nil
+		}
+	}
+	return nil, fmt.Errorf("task %s of environment %s: %w", s,
+		environmentName, ErrNotFound)
+}
+
+// CancelTask cancels the task of an environment selected by s, and returns
+// the task in its state after it was cancelled.
+func CancelTask(ctx context.Context, t TaskCanceller,
+	projectName, environmentName string, s Selector) (*schema.Task, error) {
+	task, err := SelectTask(ctx, t, projectName, environmentName, s)
+	if err != nil {
+		return nil, err
+	}
+	var result string
+	err = t.CancelTask(ctx, &schema.CancelTaskInput{
+		Task: schema.TaskInput{ID: task.ID},
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return SelectTask(ctx, t, projectName, environmentName,
+		Selector{ID: task.ID})
+}
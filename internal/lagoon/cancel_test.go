@@ -0,0 +1,61 @@
+//go:generate mockgen -source=cancel.go -destination=../mock/mock_cancel.go -package=mock -aux_files=github.com/amazeeio/lagoon-cli/internal/lagoon=deployment.go,github.com/amazeeio/lagoon-cli/internal/lagoon=environment.go
+package lagoon_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/mock"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/golang/mock/gomock"
+)
+
+func TestCancelDeployment(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockDeploymentCanceller(ctrl)
+	running := []schema.Deployment{
+		{ID: 1, Name: "build-1", RemoteID: "abc", Status: "complete"},
+		{ID: 2, Name: "build-2", RemoteID: "def", Status: "running"},
+	}
+	cancelled := []schema.Deployment{running[0],
+		{ID: 2, Name: "build-2", RemoteID: "def", Status: "cancelled"}}
+	d.EXPECT().ProjectInfoByName(ctx, "bananas", gomock.Any()).
+		DoAndReturn(setProject(bananas(7))).Times(2)
+	gomock.InOrder(
+		d.EXPECT().DeploymentsByEnvironment(ctx, "master", uint(7),
+			gomock.Any()).SetArg(3, running),
+		d.EXPECT().CancelDeployment(ctx, &schema.CancelDeploymentInput{
+			Deployment: schema.DeploymentInput{ID: 2},
+		}, gomock.Any()).SetArg(2, "success"),
+		d.EXPECT().DeploymentsByEnvironment(ctx, "master", uint(7),
+			gomock.Any()).SetArg(3, cancelled),
+	)
+	deployment, err := lagoon.CancelDeployment(ctx, d, "bananas", "master",
+		lagoon.Selector{RemoteID: "def"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deployment.Name != "build-2" || deployment.Status != "cancelled" {
+		t.Fatalf("unexpected deployment: %v", deployment)
+	}
+}
+
+func TestCancelTask(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockTaskCanceller(ctrl)
+	c.EXPECT().ProjectInfoByName(ctx, "bananas", gomock.Any()).
+		DoAndReturn(setProject(bananas(7)))
+	c.EXPECT().TasksByEnvironment(ctx, "master", uint(7), gomock.Any()).
+		SetArg(3, []schema.Task{{ID: 3, Name: "Drush cache-clear"}})
+	_, err := lagoon.CancelTask(ctx, c, "bananas", "master",
+		lagoon.Selector{ID: 4})
+	if !errors.Is(err, lagoon.ErrNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
@@ -5,6 +5,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -19,35 +20,53 @@ import (
 
 const lagoonCLIVersion = "0.x.x"
 
+// runner is implemented by graphql.Client, and allows requests to be
+// intercepted.
+type runner interface {
+	Run(ctx context.Context, req *graphql.Request, resp interface{}) error
+}
+
 // Client implements the lagoon package interfaces for the Lagoon GraphQL API.
 type Client struct {
 	userAgent  string
 	token      string
 	apiVersion string
-	client     *graphql.Client
+	client     runner
+	retry      RetryPolicy
 }
 
-// New creates a new Client for the given endpoint.
+// New creates a new Client for the given endpoint. The Client retries
+// transient failures according to DefaultRetryPolicy.
 func New(endpoint, token, apiVersion string, debug bool) *Client {
+	// the default TLSConfig is always valid
+	c, _ := NewWithTLS(endpoint, token, apiVersion, debug, TLSConfig{})
+	return c
+}
+
+// NewWithTLS creates a new Client for the given endpoint, like New, which uses
+// the given TLS configuration.
+func NewWithTLS(endpoint, token, apiVersion string, debug bool,
+	tlsConfig TLSConfig) (*Client, error) {
+	httpClient, err := tlsConfig.HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	opts := []graphql.ClientOption{graphql.WithHTTPClient(httpClient)}
 	if debug {
-		return &Client{
-			apiVersion: apiVersion,
-			token:      token,
-			client: graphql.NewClient(endpoint,
-				// enable debug logging to stderr
-				func(c *graphql.Client) {
-					l := log.New(os.Stderr, "graphql", 0)
-					c.Log = func(s string) {
-						l.Println(s)
-					}
-				}),
-		}
+		// enable debug logging to stderr
+		opts = append(opts, func(c *graphql.Client) {
+			l := log.New(os.Stderr, "graphql", 0)
+			c.Log = func(s string) {
+				l.Println(s)
+			}
+		})
 	}
 	return &Client{
 		apiVersion: apiVersion,
 		token:      token,
-		client:     graphql.NewClient(endpoint),
-	}
+		retry:      DefaultRetryPolicy,
+		client:     graphql.NewClient(endpoint, opts...),
+	}, nil
 }
 
 // newRequest constructs a graphql request.
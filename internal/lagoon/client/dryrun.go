@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+	"github.com/machinebox/graphql"
+)
+
+var (
+	// varDeclaration matches a variable declaration in the header of a query.
+	varDeclaration = regexp.MustCompile(`\$(\w+):\s*(\w+)(!?)`)
+	// operation matches the header and first field of a query.
+	operation = regexp.MustCompile(`^\s*(query|mutation)\s*(\([^)]*\))?\s*{\s*(\w+)`)
+)
+
+// enumValues are the valid values of the Lagoon API enum types used in
+// queries.
+var enumValues = map[string][]string{
+	"Currency": {string(schema.AUD), string(schema.EUR), string(schema.GBP),
+		string(schema.USD), string(schema.CHF), string(schema.ZAR)},
+	"DeployType": {string(api.Branch), string(api.PullRequest),
+		string(api.Promote)},
+	"EnvType": {string(api.ProductionEnv), string(api.DevelopmentEnv)},
+	"EnvVariableScope": {string(api.BuildVar), string(api.RuntimeVar),
+		string(api.GlobalVar), string(api.InternalContainerRegistryVar),
+		string(api.ContainerRegistryVar)},
+	"EnvVariableType": {string(api.ProjectVar), string(api.EnvironmentVar)},
+	"GroupRole": {string(api.GuestRole), string(api.ReporterRole),
+		string(api.DeveloperRole), string(api.MaintainerRole),
+		string(api.OwnerRole)},
+	"NotificationType": {string(api.SlackNotification),
+		string(api.RocketChatNotification), string(api.EmailNotification),
+		string(api.MicrosoftTeamsNotification)},
+	"SshKeyType": {string(api.SSHRsa), string(api.SSHEd25519)},
+}
+
+// simulatedIDs are the mutations for which the Recorder simulates the ID of
+// the created object.
+var simulatedIDs = map[string]bool{
+	"addProject":             true,
+	"addOrUpdateEnvironment": true,
+}
+
+// sensitiveVars are the request variables which may hold secrets, and are
+// not revealed in recorded requests.
+var sensitiveVars = map[string]bool{
+	"privateKey": true,
+	"value":      true,
+	"webhook":    true,
+}
+
+// Request is a GraphQL request recorded by a Recorder.
+type Request struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// Recorder records the mutations made by a dry-run Client instead of sending
+// them to the Lagoon API.
+type Recorder struct {
+	mu       sync.Mutex
+	lastID   uint
+	requests []Request
+}
+
+// NewDryRun creates a new Client which records mutations in the returned
+// Recorder instead of sending them to the Lagoon API. The variables of each
+// request are validated against the variable types declared in the query.
+// Queries are not recorded and return empty responses, and created projects
+// and environments are given simulated IDs. The values of sensitive variables
+// such as envVariable values are replaced with "(sensitive)" in the recorded
+// requests.
+func NewDryRun(apiVersion string) (*Client, *Recorder) {
+	r := Recorder{}
+	return &Client{
+		apiVersion: apiVersion,
+		client:     &r,
+	}, &r
+}
+
+// Requests returns the mutations recorded so far, in order.
+func (r *Recorder) Requests() []Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Request(nil), r.requests...)
+}
+
+// Run implements the runner interface.
+func (r *Recorder) Run(
+	_ context.Context, req *graphql.Request, resp interface{}) error {
+	match := operation.FindStringSubmatch(req.Query())
+	if match == nil {
+		return fmt.Errorf("couldn't parse query")
+	}
+	if match[1] != "mutation" {
+		return nil // queries return an empty response
+	}
+	field := match[3]
+	if err := validateVars(field, match[2], req.Vars()); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, Request{
+		Query:     req.Query(),
+		Variables: redactVars(req.Vars()),
+	})
+	if !simulatedIDs[field] {
+		return nil
+	}
+	r.lastID++
+	data, err := json.Marshal(map[string]interface{}{
+		field: map[string]interface{}{"id": r.lastID},
+	})
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, resp)
+}
+
+// redactVars returns a copy of vars with the values of sensitive variables
+// replaced.
+func redactVars(vars map[string]interface{}) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(vars))
+	for k, v := range vars {
+		if s, ok := v.(string); ok && s != "" && sensitiveVars[k] {
+			v = "(sensitive)"
+		}
+		redacted[k] = v
+	}
+	return redacted
+}
+
+// validateVars checks the given variables against the variable declarations
+// of a query.
+func validateVars(field, declarations string,
+	vars map[string]interface{}) error {
+	for _, d := range varDeclaration.FindAllStringSubmatch(declarations, -1) {
+		name, varType, required := d[1], d[2], d[3] == "!"
+		value, ok := vars[name]
+		if required {
+			switch value {
+			case nil, "", float64(0):
+				return fmt.Errorf("invalid %s: variable %s is required", field, name)
+			}
+		}
+		allowed, isEnum := enumValues[varType]
+		if !ok || !isEnum {
+			continue
+		}
+		valid := false
+		for _, v := range allowed {
+			if value == v {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid %s: variable %s must be one of %s, not %v",
+				field, name, strings.Join(allowed, ", "), value)
+		}
+	}
+	return nil
+}
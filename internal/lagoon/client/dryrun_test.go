@@ -0,0 +1,122 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/lagoon/client"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+func TestDryRunImport(t *testing.T) {
+	var testCases = map[string]struct {
+		input  string
+		expect string
+	}{
+		"simple": {
+			input:  "testdata/dryRun.import.yaml",
+			expect: "testdata/dryRunImport.golden.json",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			c, r := client.NewDryRun("1.0.0")
+			file, err := os.Open(tc.input)
+			if err != nil {
+				tt.Fatalf("couldn't open file: %v", err)
+			}
+			defer file.Close()
+			if err = lagoon.Import(context.Background(), c, file, false, 1, 1, nil); err != nil {
+				tt.Fatalf("couldn't import: %v", err)
+			}
+			result, err := json.MarshalIndent(r.Requests(), "", "  ")
+			if err != nil {
+				tt.Fatalf("couldn't marshal requests: %v", err)
+			}
+
+			if *update {
+				tt.Logf("update golden file: %s", tc.expect)
+				if err = ioutil.WriteFile(tc.expect, result, 0644); err != nil {
+					tt.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+
+			expected, err := ioutil.ReadFile(tc.expect)
+			if err != nil {
+				tt.Fatalf("failed reading golden file: %v", err)
+			}
+			if !bytes.Equal(result, expected) {
+				tt.Logf("result:\n%s\nexpected:\n%s", result, expected)
+				tt.Errorf("result does not match expected")
+			}
+		})
+	}
+}
+
+func TestDryRunValidation(t *testing.T) {
+	var testCases = map[string]struct {
+		input *schema.AddEnvironmentInput
+		valid bool
+	}{
+		"valid": {
+			input: &schema.AddEnvironmentInput{
+				Name:                 "master",
+				ProjectID:            1,
+				DeployType:           "BRANCH",
+				DeployBaseRef:        "master",
+				EnvironmentType:      "PRODUCTION",
+				OpenshiftProjectName: "bananas-master",
+			},
+			valid: true,
+		},
+		"missing project": {
+			input: &schema.AddEnvironmentInput{
+				Name:                 "master",
+				DeployType:           "BRANCH",
+				DeployBaseRef:        "master",
+				EnvironmentType:      "PRODUCTION",
+				OpenshiftProjectName: "bananas-master",
+			},
+		},
+		"invalid enum": {
+			input: &schema.AddEnvironmentInput{
+				Name:                 "master",
+				ProjectID:            1,
+				DeployType:           "branch",
+				DeployBaseRef:        "master",
+				EnvironmentType:      "PRODUCTION",
+				OpenshiftProjectName: "bananas-master",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			c, r := client.NewDryRun("1.0.0")
+			env := schema.Environment{}
+			err := c.AddOrUpdateEnvironment(context.Background(), tc.input, &env)
+			if tc.valid {
+				if err != nil {
+					tt.Fatalf("unexpected error: %v", err)
+				}
+				if env.ID != 1 {
+					tt.Fatalf("expected simulated ID 1, got %d", env.ID)
+				}
+				if len(r.Requests()) != 1 {
+					tt.Fatalf("expected 1 request, got %d", len(r.Requests()))
+				}
+				return
+			}
+			if err == nil {
+				tt.Fatalf("expected error, got nil")
+			}
+			if len(r.Requests()) != 0 {
+				tt.Fatalf("expected no requests, got %d", len(r.Requests()))
+			}
+		})
+	}
+}
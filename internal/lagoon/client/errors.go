@@ -0,0 +1,77 @@
+package client
+
+import (
+	"regexp"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+)
+
+var (
+	// statusCode matches the error returned by graphql.Client when the API
+	// responds with a non-200 status code and a body which isn't a GraphQL
+	// response.
+	statusCode = regexp.MustCompile(
+		`^graphql: server returned a non-200 status code: (\d+)`)
+	duplicate = regexp.MustCompile(
+		`^graphql: (Duplicate entry |.* already exists)`)
+	notFound = regexp.MustCompile(
+		`^graphql: (.* not found|No .* found|.* does not exist)`)
+	unauthorized = regexp.MustCompile(
+		`(?i)^graphql: (Unauthorized - |jwt |invalid token|token expired)`)
+	forbidden = regexp.MustCompile(
+		`^graphql: Unauthorized: You don't have permission`)
+)
+
+// apiError is an error returned by the Lagoon API, which is classified as one
+// of the lagoon package error types. The message of the original error is
+// unchanged.
+type apiError struct {
+	kind error
+	err  error
+}
+
+func (e *apiError) Error() string {
+	return e.err.Error()
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+func (e *apiError) Is(target error) bool {
+	return target == e.kind
+}
+
+// classifyErr wraps a response error with a lagoon package error type, if
+// the error is one which callers may want to check for.
+func classifyErr(err error) error {
+	if err == nil {
+		return nil
+	}
+	var kind error
+	msg := err.Error()
+	switch {
+	case forbidden.MatchString(msg):
+		kind = lagoon.ErrForbidden
+	case unauthorized.MatchString(msg):
+		kind = lagoon.ErrUnauthorized
+	case duplicate.MatchString(msg):
+		kind = lagoon.ErrExist
+	case notFound.MatchString(msg):
+		kind = lagoon.ErrNotFound
+	}
+	if match := statusCode.FindStringSubmatch(msg); match != nil {
+		switch match[1] {
+		case "401":
+			kind = lagoon.ErrUnauthorized
+		case "403":
+			kind = lagoon.ErrForbidden
+		case "404":
+			kind = lagoon.ErrNotFound
+		}
+	}
+	if kind == nil {
+		return err
+	}
+	return &apiError{kind: kind, err: err}
+}
@@ -14,11 +14,58 @@
 // _lgraphql/addProject.graphql
 // _lgraphql/addProjectToBillingGroup.graphql
 // _lgraphql/addSshKey.graphql
+// _lgraphql/addTask.graphql
 // _lgraphql/addUser.graphql
 // _lgraphql/addUserToGroup.graphql
+// _lgraphql/allGroupNames.graphql
+// _lgraphql/allGroups.graphql
+// _lgraphql/allNotificationsRocketChat.graphql
+// _lgraphql/allNotificationsSlack.graphql
+// _lgraphql/allProjects.graphql
+// _lgraphql/allProjectsInfo.graphql
+// _lgraphql/cancelDeployment.graphql
+// _lgraphql/cancelTask.graphql
+// _lgraphql/deleteBillingGroup.graphql
+// _lgraphql/deleteEnvVariable.graphql
+// _lgraphql/deleteEnvironment.graphql
+// _lgraphql/deleteGroup.graphql
+// _lgraphql/deleteNotificationEmail.graphql
+// _lgraphql/deleteNotificationMicrosoftTeams.graphql
+// _lgraphql/deleteNotificationRocketChat.graphql
+// _lgraphql/deleteNotificationSlack.graphql
+// _lgraphql/deleteProject.graphql
+// _lgraphql/deleteSshKey.graphql
+// _lgraphql/deleteUser.graphql
+// _lgraphql/deployEnvironmentBranch.graphql
+// _lgraphql/deployEnvironmentPromote.graphql
+// _lgraphql/deployEnvironmentPullrequest.graphql
+// _lgraphql/deploymentByRemoteId.graphql
+// _lgraphql/deploymentsByEnvironment.graphql
 // _lgraphql/environmentByName.graphql
+// _lgraphql/environmentsByProjectName.graphql
+// _lgraphql/groupByName.graphql
 // _lgraphql/me.graphql
+// _lgraphql/notificationsRocketChatByProject.graphql
+// _lgraphql/notificationsSlackByProject.graphql
 // _lgraphql/projectByName.graphql
+// _lgraphql/projectInfoByName.graphql
+// _lgraphql/projectsByGroup.graphql
+// _lgraphql/removeGroupsFromProject.graphql
+// _lgraphql/removeNotificationFromProject.graphql
+// _lgraphql/removeProjectFromBillingGroup.graphql
+// _lgraphql/removeUserFromGroup.graphql
+// _lgraphql/taskById.graphql
+// _lgraphql/taskDrushArchiveDump.graphql
+// _lgraphql/taskDrushCacheClear.graphql
+// _lgraphql/taskDrushSqlDump.graphql
+// _lgraphql/tasksByEnvironment.graphql
+// _lgraphql/updateNotificationEmail.graphql
+// _lgraphql/updateNotificationMicrosoftTeams.graphql
+// _lgraphql/updateNotificationRocketChat.graphql
+// _lgraphql/updateNotificationSlack.graphql
+// _lgraphql/updateProject.graphql
+// _lgraphql/updateUser.graphql
+// _lgraphql/userByEmail.graphql
 package lgraphql
 
 import (
@@ -355,6 +402,26 @@ func _lgraphqlAddsshkeyGraphql() (*asset, error) {
 	return a, nil
 }
 
+var __lgraphqlAddtaskGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\xce\x41\x0a\xc2\x30\x10\x85\xe1\x7d\x4e\xf1\x84\x2e\x2a\x78\x82\x2c\xdd\xb9\xd6\x0b\x0c\xcd\x20\x41\x33\x91\x66\x52\x04\xe9\xdd\xc5\x76\x5a\x6a\x77\x8f\x3f\x19\xf8\x52\x55\xd2\x98\x05\xad\x03\x1a\xa1\xc4\x1e\x57\xed\xa3\xdc\x0f\xa7\x5f\x61\x19\x62\x9f\x25\xb1\xa8\xc7\x45\x74\xae\x85\xfb\x21\x76\xbb\xaf\x5d\x4e\x89\x24\xec\xee\xdf\xdc\x55\x65\x8f\x73\xce\x4f\x26\x39\xe2\xe3\x00\x80\x42\xb8\x51\x79\xb4\x51\x5e\x55\xbd\x45\x60\x06\x4c\x0e\x2b\x7f\x80\x2d\xc7\xde\x57\xca\x82\xb2\xbe\x6a\x1a\x5b\xd6\x57\xd0\x42\x9b\xfa\xb8\xb8\x80\x18\x6c\x6c\x10\x45\x49\x6b\x71\x00\x30\x3a\x60\x74\xdf\x01\x00\x36\x3b\x09\x8c\x37\x01\x00\x00")
+
+func _lgraphqlAddtaskGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlAddtaskGraphql,
+		"_lgraphql/addTask.graphql",
+	)
+}
+
+func _lgraphqlAddtaskGraphql() (*asset, error) {
+	bytes, err := _lgraphqlAddtaskGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/addTask.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var __lgraphqlAdduserGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\x31\x0e\xc2\x30\x0c\x85\xe1\x3d\xa7\x78\x48\x0c\xad\xc4\x09\x7a\x08\x16\xc4\x01\x2c\x12\x90\xa5\xda\x45\xa9\x3b\x21\xee\x8e\xda\x3a\x69\x06\x06\x2f\xff\x1b\x3e\xcb\x62\x64\x3c\x29\xba\x00\x9c\x93\x10\x8f\x03\x6e\x96\x59\x5f\xa7\xcb\x9a\x9e\x9c\x67\xbb\x92\xa4\x92\xb7\x3a\xd2\x9f\xf8\x98\x44\x92\x5a\x69\x3d\x3e\x01\x00\x28\xc6\xfb\x9c\x72\xc7\xfa\x5e\x6c\xf0\x08\xb8\xb5\x9b\xde\x1a\xec\x80\x7d\x3b\xc8\xaa\xfb\x52\xdd\xf2\xc1\xd6\xbf\x7d\xa5\x38\xb6\xe6\xbe\x86\xf5\x7e\x01\x00\x00\xff\xff\xf7\xf8\x9f\x0d\xfe\x00\x00\x00")
 
 func _lgraphqlAdduserGraphqlBytes() ([]byte, error) {
@@ -395,62 +462,982 @@ func _lgraphqlAddusertogroupGraphql() (*asset, error) {
 	return a, nil
 }
 
-var __lgraphqlEnvironmentbynameGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8e\x4d\x0e\x82\x30\x10\x85\xf7\x9c\xe2\x91\xb8\x80\x84\x13\xb0\x74\xe7\x86\x98\xe8\x05\x08\x1d\xb5\x06\xa6\xb5\x0c\x26\x0d\xf1\xee\x86\x1f\x5b\x70\xd1\xb4\xdf\xd7\xce\xeb\x7b\x0d\xe4\x3c\xb2\x04\x38\x70\xdd\x51\x89\x8b\x38\xcd\xf7\xb4\x98\x8c\x75\xe6\x49\x8d\x94\x38\xb1\xa4\x39\xc6\x04\x00\x88\xdf\xda\x19\xee\x88\xe5\xe8\xab\xba\xa3\x6c\xd6\xc0\x32\x3f\xc7\x14\xab\x0a\x01\xbf\xa8\x7c\xbd\x18\xd7\x1d\xd0\x2a\x1c\xa7\xc9\x00\xce\x0c\xf2\x47\x7d\x40\x45\xb6\x35\xfe\xea\x6d\x7c\xb1\xa9\xb5\xf3\xc6\x12\xf7\x0f\x7d\x93\xf3\xd2\xa0\xda\x7e\x32\x58\x55\x0b\xc5\x06\x8d\xa3\x1d\x2b\x6a\x29\xf2\x27\x99\xd6\x37\x00\x00\xff\xff\xd5\xce\x35\x6e\x32\x01\x00\x00")
+var __lgraphqlAllgroupnamesGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x31\x00\xce\xff\x71\x75\x65\x72\x79\x20\x7b\x0a\x20\x20\x20\x20\x61\x6c\x6c\x47\x72\x6f\x75\x70\x73\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x03\x00\xf8\xde\x74\xf7\x31\x00\x00\x00")
 
-func _lgraphqlEnvironmentbynameGraphqlBytes() ([]byte, error) {
+func _lgraphqlAllgroupnamesGraphqlBytes() ([]byte, error) {
 	return bindataRead(
-		__lgraphqlEnvironmentbynameGraphql,
-		"_lgraphql/environmentByName.graphql",
+		__lgraphqlAllgroupnamesGraphql,
+		"_lgraphql/allGroupNames.graphql",
 	)
 }
 
-func _lgraphqlEnvironmentbynameGraphql() (*asset, error) {
-	bytes, err := _lgraphqlEnvironmentbynameGraphqlBytes()
+func _lgraphqlAllgroupnamesGraphql() (*asset, error) {
+	bytes, err := _lgraphqlAllgroupnamesGraphqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "_lgraphql/environmentByName.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "_lgraphql/allGroupNames.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var __lgraphqlMeGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x2c\x4d\x2d\xaa\x54\xa8\xe6\x52\x50\x50\x50\xc8\x4d\x85\x32\x40\x20\x33\x05\xce\x4c\xcd\x4d\xcc\xcc\x81\xf3\xd2\x32\x8b\x8a\x4b\xfc\x12\x73\x53\xe1\x22\x39\x89\x48\x02\xb5\x5c\xb5\x80\x00\x00\x00\xff\xff\xd4\x0b\x94\x75\x54\x00\x00\x00")
+var __lgraphqlAllgroupsGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x31\x0e\xc2\x30\x0c\x45\xf7\x9c\xe2\x0f\x0c\x70\x05\x2e\xc0\x80\xc4\x02\x62\x0f\x60\x20\x90\xb4\xc5\x6e\x86\x08\xf5\xee\xc8\x54\xad\x6a\xb5\xfa\x1e\x9c\xef\xe7\xe4\xe7\x93\x89\x0b\xd6\x0e\x58\x55\x3e\xd1\x16\xc7\x96\x43\xf5\xd8\xe0\xeb\x00\xc0\xc7\xb8\xe3\x3a\x37\xa2\x84\xaa\x87\xfe\xec\xc0\xa8\xc2\x6d\x6c\x75\x34\x1e\x12\xa5\x0b\xb1\x4c\x48\x20\x0b\xb1\x31\xcc\xba\x16\x25\x1f\xa2\x71\xee\x81\xa5\x3d\x4c\x6f\x56\x45\xbf\x60\x8a\x3c\xf7\x54\xec\x93\xb3\x5c\x7d\xbd\xa9\x9c\x4a\xb3\xe0\x9e\x7d\xcc\xd6\xee\xdc\x72\xcf\x75\x24\x37\x1f\x34\x5c\xbf\xe8\xda\xda\x14\xe6\x97\x26\xce\xb0\xd8\x39\xa0\x73\xbf\x01\x00\xe2\xc9\x57\xa4\x94\x01\x00\x00")
 
-func _lgraphqlMeGraphqlBytes() ([]byte, error) {
+func _lgraphqlAllgroupsGraphqlBytes() ([]byte, error) {
 	return bindataRead(
-		__lgraphqlMeGraphql,
-		"_lgraphql/me.graphql",
+		__lgraphqlAllgroupsGraphql,
+		"_lgraphql/allGroups.graphql",
 	)
 }
 
-func _lgraphqlMeGraphql() (*asset, error) {
-	bytes, err := _lgraphqlMeGraphqlBytes()
+func _lgraphqlAllgroupsGraphql() (*asset, error) {
+	bytes, err := _lgraphqlAllgroupsGraphqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "_lgraphql/me.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "_lgraphql/allGroups.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var __lgraphqlProjectbynameGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\x4f\x6f\xdb\x3e\x0c\xbd\xf7\x53\xf0\x57\xfc\x0e\xdd\xc5\xd8\x76\xdc\x6d\xed\x8a\x6e\xe8\x96\x05\x49\x96\x6b\xc1\xc8\x74\xac\x45\x96\x1c\x8a\x76\x61\x04\xf9\xee\x83\x13\xd4\x96\xff\x24\x0d\xd0\x61\xba\x84\x79\x8f\x26\x9f\x48\x89\xda\x16\xc4\x15\xdc\x5c\x01\xfc\x6f\x31\xa3\x4f\x30\x17\xd6\x76\xfd\xdf\x3b\xd8\x5d\x01\x00\xe4\xec\x7e\x93\x92\xdb\x6a\x82\x19\xdd\x1c\x20\x80\xa3\xe7\xe1\x83\x17\xbf\x7a\xe9\xb8\x31\x6b\xaa\xf9\x83\x85\xb8\x6f\xb1\x69\x81\x15\xa3\x55\x29\xf9\x06\xc8\x0b\x63\x98\xb6\x05\x79\x09\x40\xd6\x25\x0a\x3d\x52\x15\x40\x2e\x2e\x94\x68\x67\xef\x6d\xa9\xd9\xd9\x8c\xac\xb4\x79\x94\xe8\x92\xe6\x95\x17\xca\xfc\x17\xca\x8d\xab\xc6\xb9\x05\xfa\xcd\x38\x33\xa3\xcc\x95\x34\xce\x4d\xd9\x65\x4e\x5a\xd2\x8b\x63\x5c\xd3\x1d\x1a\xd5\x60\x2e\x27\xeb\x53\x9d\xc8\xf4\x58\xb7\x29\x8a\x10\xdb\x86\x8f\xa9\x24\xe3\xf2\x5a\x76\xb0\x03\xff\x5d\x67\xba\xdd\xc7\x5a\xcb\x2f\x36\xa7\xcb\xb7\x66\x57\xe4\xbe\xad\x3b\x40\x14\x45\xe0\x2c\x3c\xd4\x04\x84\x04\xc0\xd3\x93\x54\x39\x75\xfa\x31\x68\x50\xbd\x32\xca\x56\xc4\xbe\xfb\x31\x40\xe1\x89\xfb\x18\x00\x65\xa8\xcd\x00\xf5\x3e\x7d\xa4\x6a\x10\x62\x34\xdd\x71\x6d\xa8\x5a\x54\xf9\x09\x66\x89\xa6\x18\x52\xfb\x01\x92\x68\xf6\x32\x19\x4b\x60\x70\x94\xe8\x87\x60\x67\xba\x2e\xa1\x43\x68\xef\x76\xa0\x13\xc0\x5c\x2f\x89\x1f\x98\x50\x88\x17\x29\xda\x9f\x7c\xbf\x2d\xd0\x40\x04\xd7\x1f\xa2\x8f\xd1\xfb\x6b\xd8\xef\x87\xcd\xb9\xd5\xc6\x68\xbb\x7e\x4b\x8f\x54\xc1\x4c\x56\x55\x1d\x70\x75\x8c\x3b\x77\x89\x3c\x23\xd3\x69\xe9\x64\xe3\x50\x58\x6b\x59\x27\x3a\xd1\x0a\xeb\x7b\xe5\x61\xe4\x5c\x4d\x02\x87\xb9\x41\xb5\xb9\x4c\xff\x33\xad\x52\xe7\x36\xaf\xec\x29\x45\x6b\xc9\x9c\x90\x3d\x22\x60\xe6\xd4\x86\xe4\x2e\x45\xf9\x67\x2a\xde\xd4\xf7\x50\xfb\x7d\x7d\x6f\x2e\x93\x7d\xb8\x62\x9f\xe3\x98\xc9\xfb\x73\xda\x5f\xc9\xf8\x43\x2b\x76\xde\x25\xb2\x20\xcc\xfc\x5f\xab\xd8\xa5\x47\xab\x99\x87\x61\xe2\xe0\x9d\x68\x3d\xc9\x96\x4b\x64\x8d\x2b\x43\x5d\x99\xbd\xcc\x5e\xb9\xce\xc0\x28\x3b\x53\xa2\x13\xaf\x19\xaf\xe7\xe2\xc5\x87\x77\xa2\x37\x85\x8e\xe0\x2d\x7a\x9a\x51\x32\xc0\xbf\x12\xc6\x63\xf8\x42\x4b\x67\x90\x04\x12\x7a\xf1\xfb\xcf\x44\x6f\x48\x0d\x26\xfe\x99\xf2\x8c\x1c\xe6\x7e\x89\xfa\x45\x0a\xcb\xf4\x62\xd5\xbf\xfb\xab\x3f\x01\x00\x00\xff\xff\x88\xc1\x98\xb6\x0e\x08\x00\x00")
+var __lgraphqlAllnotificationsrocketchatGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8e\x41\xca\xc2\x30\x10\x85\xf7\x39\xc5\x5b\xfe\xff\xa6\x07\xe8\x4e\x8a\x20\x08\x2a\xa5\x17\x88\x71\xa4\xb1\x75\x46\x93\x11\x29\x92\xbb\x4b\x45\x4a\x22\xbc\xc5\x7c\x1f\x33\x8f\xb9\x3f\x28\x4c\x78\x19\x00\xb0\xe3\x78\x08\x72\x21\xa7\xf1\xc3\xf8\xfa\x39\x6c\xaf\xb4\x40\x10\x37\x90\x36\xbd\xd5\x58\x83\x45\xfd\xd9\x3b\xab\x5e\x38\xfe\xe9\x74\xa3\x1a\xed\xbe\xd9\xae\xbb\x66\xb3\xea\xfe\xb3\x0e\xa0\xaa\x2a\x08\x63\x97\x9d\xb4\x4b\x57\xb1\x09\xf8\x53\x81\xc5\x03\x73\x9e\x74\xec\x45\x86\xc2\xb9\xde\x32\xd3\x98\xb9\x64\x7e\xa7\x64\x80\x64\xde\x03\x00\x3f\x5e\xaa\xec\xf8\x00\x00\x00")
 
-func _lgraphqlProjectbynameGraphqlBytes() ([]byte, error) {
+func _lgraphqlAllnotificationsrocketchatGraphqlBytes() ([]byte, error) {
 	return bindataRead(
-		__lgraphqlProjectbynameGraphql,
-		"_lgraphql/projectByName.graphql",
+		__lgraphqlAllnotificationsrocketchatGraphql,
+		"_lgraphql/allNotificationsRocketChat.graphql",
 	)
 }
 
-func _lgraphqlProjectbynameGraphql() (*asset, error) {
-	bytes, err := _lgraphqlProjectbynameGraphqlBytes()
+func _lgraphqlAllnotificationsrocketchatGraphql() (*asset, error) {
+	bytes, err := _lgraphqlAllnotificationsrocketchatGraphqlBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "_lgraphql/projectByName.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	info := bindataFileInfo{name: "_lgraphql/allNotificationsRocketChat.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlAllnotificationsslackGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8d\x41\x0a\x02\x31\x0c\x45\xf7\x3d\xc5\x5f\xea\xa6\x07\x98\x9d\xb8\x54\x44\x98\x13\xc4\x1a\x99\x3a\x35\xd1\x69\x45\x06\xe9\xdd\xa5\x22\xd2\x0e\xfc\x45\xde\xe3\x27\x79\x3c\x79\x9a\xf1\x36\x00\x40\x21\x1c\x27\xbd\xb2\x4b\xf1\xcb\xf8\xf9\x12\xa1\x1b\xff\x21\x06\x72\x63\xec\x20\x9a\xfc\xc5\x3b\x4a\x5e\x25\xae\xd2\x7c\xe7\x0e\xfd\x7e\xb3\xdd\xad\xab\x4d\xc0\x5a\x0b\x15\x1c\xaa\x76\x5f\x2e\x34\x25\xc0\x9f\x1b\x6c\x3e\x96\xbc\xf8\x34\xa8\x8e\x8d\x73\x03\x89\x70\xa8\x5c\x36\xcb\x29\x1b\x20\x9b\xcf\x00\x79\x85\x2b\x2f\xe9\x00\x00\x00")
+
+func _lgraphqlAllnotificationsslackGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlAllnotificationsslackGraphql,
+		"_lgraphql/allNotificationsSlack.graphql",
+	)
+}
+
+func _lgraphqlAllnotificationsslackGraphql() (*asset, error) {
+	bytes, err := _lgraphqlAllnotificationsslackGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/allNotificationsSlack.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlAllprojectsGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x39\x00\xc6\xff\x71\x75\x65\x72\x79\x20\x7b\x0a\x20\x20\x20\x20\x61\x6c\x6c\x50\x72\x6f\x6a\x65\x63\x74\x73\x0a\x20\x20\x20\x20\x20\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x0a\x20\x20\x20\x20\x20\x20\x7d\x0a\x20\x20\x7d\x0a\x03\x00\xc8\x5d\x9c\xfe\x39\x00\x00\x00")
+
+func _lgraphqlAllprojectsGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlAllprojectsGraphql,
+		"_lgraphql/allProjects.graphql",
+	)
+}
+
+func _lgraphqlAllprojectsGraphql() (*asset, error) {
+	bytes, err := _lgraphqlAllprojectsGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/allProjects.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlAllprojectsinfoGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x2c\x4d\x2d\xaa\x54\xa8\xe6\x52\x50\x50\x50\x48\xcc\xc9\x09\x28\xca\xcf\x4a\x4d\x2e\x29\x06\xf3\x15\xa0\xe2\x20\x94\x99\x02\x67\xe6\x25\xe6\xa6\xc2\x39\xe9\x99\x25\xa1\x45\x39\x70\x6e\x4a\x6a\x59\x6a\x4e\x7e\x41\x6e\x6a\x5e\x89\x6b\x5e\x59\x66\x51\x7e\x1e\x88\x59\xec\x93\x99\x9b\x59\x02\x57\x94\x8a\x24\x83\x64\x05\x8a\x44\x48\x65\x01\xc2\x92\x5a\x28\xab\x96\x4b\x41\xa1\x96\x0b\x30\x00\x85\xb4\x76\x05\xb3\x00\x00\x00")
+
+func _lgraphqlAllprojectsinfoGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlAllprojectsinfoGraphql,
+		"_lgraphql/allProjectsInfo.graphql",
+	)
+}
+
+func _lgraphqlAllprojectsinfoGraphql() (*asset, error) {
+	bytes, err := _lgraphqlAllprojectsinfoGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/allProjectsInfo.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlCanceldeploymentGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x75\x00\x8a\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x64\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x3a\x20\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x49\x6e\x70\x75\x74\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x63\x61\x6e\x63\x65\x6c\x44\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x64\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x3a\x20\x24\x64\x65\x70\x6c\x6f\x79\x6d\x65\x6e\x74\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\xdf\x17\x8f\x88\x75\x00\x00\x00")
+
+func _lgraphqlCanceldeploymentGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlCanceldeploymentGraphql,
+		"_lgraphql/cancelDeployment.graphql",
+	)
+}
+
+func _lgraphqlCanceldeploymentGraphql() (*asset, error) {
+	bytes, err := _lgraphqlCanceldeploymentGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/cancelDeployment.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlCanceltaskGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x57\x00\xa8\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x74\x61\x73\x6b\x3a\x20\x54\x61\x73\x6b\x49\x6e\x70\x75\x74\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x63\x61\x6e\x63\x65\x6c\x54\x61\x73\x6b\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x74\x61\x73\x6b\x3a\x20\x24\x74\x61\x73\x6b\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\x30\xe5\x7f\xe6\x57\x00\x00\x00")
+
+func _lgraphqlCanceltaskGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlCanceltaskGraphql,
+		"_lgraphql/cancelTask.graphql",
+	)
+}
+
+func _lgraphqlCanceltaskGraphql() (*asset, error) {
+	bytes, err := _lgraphqlCanceltaskGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/cancelTask.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeletebillinggroupGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x63\x00\x9c\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x67\x72\x6f\x75\x70\x3a\x20\x47\x72\x6f\x75\x70\x49\x6e\x70\x75\x74\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x42\x69\x6c\x6c\x69\x6e\x67\x47\x72\x6f\x75\x70\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x67\x72\x6f\x75\x70\x3a\x20\x24\x67\x72\x6f\x75\x70\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\x4e\x61\xed\x23\x63\x00\x00\x00")
+
+func _lgraphqlDeletebillinggroupGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeletebillinggroupGraphql,
+		"_lgraphql/deleteBillingGroup.graphql",
+	)
+}
+
+func _lgraphqlDeletebillinggroupGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeletebillinggroupGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deleteBillingGroup.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeleteenvvariableGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x52\x00\xad\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x69\x64\x3a\x20\x49\x6e\x74\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x45\x6e\x76\x56\x61\x72\x69\x61\x62\x6c\x65\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x69\x64\x3a\x20\x24\x69\x64\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\xce\xee\x26\x1b\x52\x00\x00\x00")
+
+func _lgraphqlDeleteenvvariableGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeleteenvvariableGraphql,
+		"_lgraphql/deleteEnvVariable.graphql",
+	)
+}
+
+func _lgraphqlDeleteenvvariableGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeleteenvvariableGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deleteEnvVariable.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeleteenvironmentGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcd\x41\x0a\xc2\x30\x10\x85\xe1\x7d\x4e\xf1\x04\x17\x2d\x78\x82\x2c\x05\x4f\xe0\x09\x42\x7d\xc8\x48\x33\x53\xc2\x44\x04\xe9\xdd\xc5\x9a\x06\xdc\x7e\x0f\xde\x9f\xab\x27\x17\x53\x0c\x01\x38\x6a\xca\x8c\xb8\x7a\x11\xbd\x1f\x4e\x5f\x59\x8a\x3d\x38\xf9\x3f\xf2\xc5\xa9\x3a\x23\xce\x66\x33\x93\x8e\x78\x07\x00\xb8\x71\xa6\xf3\xa2\x4f\x29\xa6\x99\xea\x83\xe8\x52\x3d\xb6\x19\xf8\xfd\x6f\x99\x26\xfd\x7f\x2f\x35\xef\x89\x3d\xb6\xf9\x3a\x06\x60\x0d\x9f\x01\x00\x6e\xac\x54\x79\xb5\x00\x00\x00")
+
+func _lgraphqlDeleteenvironmentGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeleteenvironmentGraphql,
+		"_lgraphql/deleteEnvironment.graphql",
+	)
+}
+
+func _lgraphqlDeleteenvironmentGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeleteenvironmentGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deleteEnvironment.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeletegroupGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x5c\x00\xa3\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x67\x72\x6f\x75\x70\x3a\x20\x47\x72\x6f\x75\x70\x49\x6e\x70\x75\x74\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x47\x72\x6f\x75\x70\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x67\x72\x6f\x75\x70\x3a\x20\x24\x67\x72\x6f\x75\x70\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\x3e\x9f\x10\x37\x5c\x00\x00\x00")
+
+func _lgraphqlDeletegroupGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeletegroupGraphql,
+		"_lgraphql/deleteGroup.graphql",
+	)
+}
+
+func _lgraphqlDeletegroupGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeletegroupGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deleteGroup.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeletenotificationemailGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x61\x00\x9e\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x6e\x61\x6d\x65\x3a\x20\x53\x74\x72\x69\x6e\x67\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x45\x6d\x61\x69\x6c\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x24\x6e\x61\x6d\x65\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\xbf\xc2\x59\xba\x61\x00\x00\x00")
+
+func _lgraphqlDeletenotificationemailGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeletenotificationemailGraphql,
+		"_lgraphql/deleteNotificationEmail.graphql",
+	)
+}
+
+func _lgraphqlDeletenotificationemailGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeletenotificationemailGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deleteNotificationEmail.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeletenotificationmicrosoftteamsGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x6a\x00\x95\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x6e\x61\x6d\x65\x3a\x20\x53\x74\x72\x69\x6e\x67\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x4d\x69\x63\x72\x6f\x73\x6f\x66\x74\x54\x65\x61\x6d\x73\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x24\x6e\x61\x6d\x65\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\x05\xaa\xb6\x0c\x6a\x00\x00\x00")
+
+func _lgraphqlDeletenotificationmicrosoftteamsGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeletenotificationmicrosoftteamsGraphql,
+		"_lgraphql/deleteNotificationMicrosoftTeams.graphql",
+	)
+}
+
+func _lgraphqlDeletenotificationmicrosoftteamsGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeletenotificationmicrosoftteamsGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deleteNotificationMicrosoftTeams.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeletenotificationrocketchatGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x66\x00\x99\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x6e\x61\x6d\x65\x3a\x20\x53\x74\x72\x69\x6e\x67\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x52\x6f\x63\x6b\x65\x74\x43\x68\x61\x74\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x24\x6e\x61\x6d\x65\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\x87\x57\xd8\xe5\x66\x00\x00\x00")
+
+func _lgraphqlDeletenotificationrocketchatGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeletenotificationrocketchatGraphql,
+		"_lgraphql/deleteNotificationRocketChat.graphql",
+	)
+}
+
+func _lgraphqlDeletenotificationrocketchatGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeletenotificationrocketchatGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deleteNotificationRocketChat.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeletenotificationslackGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x61\x00\x9e\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x6e\x61\x6d\x65\x3a\x20\x53\x74\x72\x69\x6e\x67\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x53\x6c\x61\x63\x6b\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x24\x6e\x61\x6d\x65\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\xb3\x61\xb1\x12\x61\x00\x00\x00")
+
+func _lgraphqlDeletenotificationslackGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeletenotificationslackGraphql,
+		"_lgraphql/deleteNotificationSlack.graphql",
+	)
+}
+
+func _lgraphqlDeletenotificationslackGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeletenotificationslackGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deleteNotificationSlack.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeleteprojectGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x60\x00\x9f\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x70\x72\x6f\x6a\x65\x63\x74\x3a\x20\x53\x74\x72\x69\x6e\x67\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x50\x72\x6f\x6a\x65\x63\x74\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x70\x72\x6f\x6a\x65\x63\x74\x3a\x20\x24\x70\x72\x6f\x6a\x65\x63\x74\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\x2e\x96\xcb\x08\x60\x00\x00\x00")
+
+func _lgraphqlDeleteprojectGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeleteprojectGraphql,
+		"_lgraphql/deleteProject.graphql",
+	)
+}
+
+func _lgraphqlDeleteprojectGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeleteprojectGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deleteProject.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeletesshkeyGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x56\x00\xa9\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x6e\x61\x6d\x65\x3a\x20\x53\x74\x72\x69\x6e\x67\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x53\x73\x68\x4b\x65\x79\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x6e\x61\x6d\x65\x3a\x20\x24\x6e\x61\x6d\x65\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\x2d\x85\x2b\x62\x56\x00\x00\x00")
+
+func _lgraphqlDeletesshkeyGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeletesshkeyGraphql,
+		"_lgraphql/deleteSshKey.graphql",
+	)
+}
+
+func _lgraphqlDeletesshkeyGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeletesshkeyGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deleteSshKey.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeleteuserGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x57\x00\xa8\xff\x6d\x75\x74\x61\x74\x69\x6f\x6e\x20\x28\x0a\x20\x20\x24\x75\x73\x65\x72\x3a\x20\x55\x73\x65\x72\x49\x6e\x70\x75\x74\x21\x29\x20\x7b\x0a\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x55\x73\x65\x72\x28\x69\x6e\x70\x75\x74\x3a\x20\x7b\x0a\x20\x20\x20\x20\x20\x20\x75\x73\x65\x72\x3a\x20\x24\x75\x73\x65\x72\x0a\x20\x20\x20\x20\x7d\x29\x0a\x20\x20\x7d\x0a\x03\x00\xab\x85\x17\x7d\x57\x00\x00\x00")
+
+func _lgraphqlDeleteuserGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeleteuserGraphql,
+		"_lgraphql/deleteUser.graphql",
+	)
+}
+
+func _lgraphqlDeleteuserGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeleteuserGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deleteUser.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeployenvironmentbranchGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\x2d\x2d\x49\x2c\xc9\xcc\xcf\x53\xd0\xe0\x52\x50\x50\x29\x28\xca\xcf\x4a\x4d\x2e\xb1\x52\x08\x80\x30\x3c\xf3\x0a\x4a\x4b\x14\x75\x40\x52\x49\x45\x89\x79\xc9\x19\x7e\x89\xb9\xa9\x56\x0a\xc1\x25\x45\x99\x79\xe9\xc8\xe2\x41\xa9\x69\x30\x61\x4d\x85\x6a\x2e\x05\x05\x05\x85\x94\xd4\x82\x9c\xfc\x4a\xd7\xbc\xb2\xcc\xa2\xfc\xbc\xdc\xd4\xbc\x12\x27\xb0\x09\x1a\x99\x20\x33\xad\xa0\x8a\x14\x14\xe0\x56\xc2\x2c\x87\x8a\x23\xdb\x87\x64\x39\x8a\x2c\xd8\x56\x84\x0b\xc0\x72\xb5\x9a\x5c\x0a\x0a\xb5\x5c\x80\x01\x00\xfb\x34\x19\x83\xd8\x00\x00\x00")
+
+func _lgraphqlDeployenvironmentbranchGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeployenvironmentbranchGraphql,
+		"_lgraphql/deployEnvironmentBranch.graphql",
+	)
+}
+
+func _lgraphqlDeployenvironmentbranchGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeployenvironmentbranchGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deployEnvironmentBranch.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeployenvironmentpromoteGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\x41\x0a\xc2\x40\x0c\x45\xf7\x73\x8a\x5f\xe8\xa2\x05\x4f\xd0\xbd\x0b\x77\x05\x4f\x20\x6d\x90\x11\x27\x19\xd2\x8c\x20\xd2\xbb\x4b\xeb\x28\x23\xd6\x5d\x92\xff\xf2\x79\x21\xd9\xc9\xbc\x30\x1a\x07\xd4\x93\x24\x1d\x68\xcf\x37\xaf\xc2\x81\xd8\x3a\x14\xcb\x81\x63\xb2\x6a\xb7\x80\x51\xe5\x42\x83\x75\xe8\x5f\x43\x11\x8d\x34\x99\xe7\xb5\xf4\xab\xe8\x68\xea\xf9\x5c\xb5\x78\x38\x00\x18\x29\x5e\xe5\x5e\x10\xbd\x4a\x10\xa3\xc6\x2f\x55\x5d\xa6\x80\x0d\xa5\x5f\xcd\xcc\x7e\xac\xde\x7e\xf9\xfe\x4f\xa9\xde\x0e\xd6\xaf\xb9\x75\xc0\xec\x9e\x03\x00\xc5\x44\x24\x7c\x20\x01\x00\x00")
+
+func _lgraphqlDeployenvironmentpromoteGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeployenvironmentpromoteGraphql,
+		"_lgraphql/deployEnvironmentPromote.graphql",
+	)
+}
+
+func _lgraphqlDeployenvironmentpromoteGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeployenvironmentpromoteGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deployEnvironmentPromote.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeployenvironmentpullrequestGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x41\x4e\x85\x30\x10\x86\xf7\x3d\xc5\x98\xb0\x80\xc4\x13\x74\x69\xe2\x82\x8d\x21\x7a\x82\x02\xa3\xd4\xb4\x53\x2c\x53\x13\x63\xb8\xbb\x01\x06\xdf\xa3\x84\x15\xf4\xff\xbf\x69\xe6\xab\x4f\x6c\xd8\x06\x82\x52\x01\x14\x63\x0c\x9f\xd8\xb1\x86\x66\xfb\xa9\x69\x4c\xfc\xf0\xb8\x54\x94\x7c\x8b\x51\x43\x4d\x12\xb0\x65\x87\x1a\xde\x38\x5a\xfa\xd8\xa2\xd6\x4c\xf8\x14\x0d\x75\xc3\x8b\xf1\x97\xdd\x2b\xbe\x1f\xab\x01\x4d\x7f\x35\x76\xeb\xee\xc7\x2a\xf8\x55\x00\x00\x3d\x8e\x2e\xfc\x3c\xd3\xb7\x8d\x81\x3c\x12\x37\xc9\xb9\x88\x5f\x09\x27\x2e\xed\xb2\xbc\x16\x12\xe0\xdf\x6d\xb7\x94\x7c\x17\x13\x43\x49\xc5\xae\x58\xbf\x92\xe5\x7a\x99\xef\x89\x5a\x37\x3e\x8a\x0b\x93\x1b\x67\x4f\x70\xa2\xb6\x9b\x0e\xe7\x95\x99\x2b\x05\x30\xab\xbf\x01\x00\xb8\xe6\xb9\x55\xc5\x01\x00\x00")
+
+func _lgraphqlDeployenvironmentpullrequestGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeployenvironmentpullrequestGraphql,
+		"_lgraphql/deployEnvironmentPullrequest.graphql",
+	)
+}
+
+func _lgraphqlDeployenvironmentpullrequestGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeployenvironmentpullrequestGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deployEnvironmentPullrequest.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeploymentbyremoteidGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8b\xbd\xaa\xc3\x30\x0c\x46\x77\x3f\xc5\x77\x21\xc3\xed\x2b\x74\xec\x56\xe8\xd4\x3e\x81\x1b\x89\x20\xf0\x4f\x2a\xcb\x83\x29\x79\xf7\x62\x48\xdd\xa0\x45\xe7\x70\xbe\x57\x65\x6d\xf8\x77\xc0\x24\x74\xc6\xc3\x54\xd2\xf2\x77\xc2\xdb\x01\x00\xf1\x1a\x72\x8b\x9c\xec\xd2\xee\x1c\xb3\xf1\x95\x7a\xdb\xaf\xe7\x93\xd0\x37\xed\x27\x34\xde\xe4\x23\x0f\xd0\x7d\x3a\x44\x31\x6f\xb5\x0c\x9c\x95\xbd\xf1\x6f\x5c\xcc\xeb\x91\xe7\x1c\xd7\xc0\x47\xf3\xac\x12\xe8\x96\x97\x5d\x6c\x0e\xd8\xdc\x67\x00\x68\xf4\xb4\xb1\xcc\x00\x00\x00")
+
+func _lgraphqlDeploymentbyremoteidGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeploymentbyremoteidGraphql,
+		"_lgraphql/deploymentByRemoteId.graphql",
+	)
+}
+
+func _lgraphqlDeploymentbyremoteidGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeploymentbyremoteidGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deploymentByRemoteId.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlDeploymentsbyenvironmentGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8d\x41\xca\x83\x30\x14\x84\xf7\x39\xc5\x08\xff\xe2\x17\x3c\x81\xcb\xee\xdc\x74\xd3\x13\x04\x1d\x8a\xc5\x24\xf6\xf9\x2c\x88\xe4\xee\x25\x35\x2d\x69\x49\x20\x79\x5f\x66\xbe\xdc\x57\xca\x86\x7f\x03\xfc\x79\xeb\xd8\xe2\xa2\x32\xfa\x6b\xd5\x24\x32\x4b\xb8\xb1\xd7\x16\x9d\xd7\xaa\xc6\x6e\x00\x80\xfe\x31\x4a\xf0\x8e\x5e\x4f\xdb\xd9\x3a\xa6\x72\x5a\x47\xff\xa5\x69\x32\xfa\x08\xde\xaa\x3a\x3f\xec\xf9\x04\x06\xce\x53\xd8\x92\x6d\xc9\x1f\x1c\x7b\x1c\x8a\x21\x39\x8b\x51\xe8\x82\xb2\x2b\x13\x8b\x5a\x5d\x97\x02\xf4\x42\xab\xfc\x89\xc8\x37\xe9\x83\x9b\x27\x96\x2c\xe6\x5b\x34\x40\x34\xcf\x01\x00\x2f\xd4\x42\xe5\x1d\x01\x00\x00")
+
+func _lgraphqlDeploymentsbyenvironmentGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlDeploymentsbyenvironmentGraphql,
+		"_lgraphql/deploymentsByEnvironment.graphql",
+	)
+}
+
+func _lgraphqlDeploymentsbyenvironmentGraphql() (*asset, error) {
+	bytes, err := _lgraphqlDeploymentsbyenvironmentGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/deploymentsByEnvironment.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlEnvironmentbynameGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x90\xc1\x4e\xc3\x30\x10\x44\xef\xf9\x8a\xa9\xc4\x81\x4a\xfd\x82\x1e\x7b\xa2\x97\x0a\x41\xe1\x6e\xe2\x2d\x18\x39\xde\x60\xaf\x23\x45\x55\xfe\x1d\x99\x98\xd8\x4d\x95\x43\x76\x9e\x35\xa3\xdd\xf9\x89\xe4\x47\x3c\x36\xc0\x83\x53\x1d\xed\xf1\x2a\xde\xb8\xcf\xcd\x2e\x91\xde\xf3\x37\xb5\xb2\xc7\xd1\xc9\x66\x8b\x6b\x03\x00\xe4\x06\xe3\xd9\x75\xe4\xe4\x30\x9e\x54\x47\xc9\x9c\xbe\xd9\xff\x17\xb3\xcb\x68\x09\xf8\x8f\xda\xe6\x87\x6b\xfe\x03\x46\x2f\x63\x72\x2e\xc2\x73\x94\x95\x0a\x8b\xec\xd8\x19\xe1\xb4\xe8\x9b\xb7\x05\x6b\xea\x2d\x8f\xe7\xb1\x2f\xc6\x19\x1d\x54\xa0\x17\xba\xac\xe8\x13\x29\x7d\x4f\xcf\x46\x6c\xf1\x57\xd7\xde\xe4\x72\x4f\x2e\x7c\x99\x8b\x3c\xcf\x87\x9d\xea\xdd\x55\x14\x3e\xea\x2a\x25\xf6\x5a\x09\x95\x4b\x5b\x4f\x37\x5a\x93\xa5\x5a\x93\x1b\xde\x95\x37\xea\xc3\x52\xc8\xb5\xdf\xb5\xb5\xea\x0b\x08\x2d\x57\x0b\x02\x83\xb2\xb1\xe8\x29\x4f\x53\x03\x4c\xcd\xef\x00\xb5\xa3\x80\x76\xf7\x01\x00\x00")
+
+func _lgraphqlEnvironmentbynameGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlEnvironmentbynameGraphql,
+		"_lgraphql/environmentByName.graphql",
+	)
+}
+
+func _lgraphqlEnvironmentbynameGraphql() (*asset, error) {
+	bytes, err := _lgraphqlEnvironmentbynameGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/environmentByName.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlEnvironmentsbyprojectnameGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x2c\x4d\x2d\xaa\x54\xd0\xe0\x52\x50\x50\xc9\x4b\xcc\x4d\xb5\x52\x08\x2e\x29\xca\xcc\x4b\x57\xd4\x54\xa8\xe6\x52\x50\x50\x50\x28\x28\xca\xcf\x4a\x4d\x2e\x71\xaa\xf4\x4b\xcc\x4d\x05\x29\x03\x41\x88\x4a\xb0\x06\x98\x3a\x10\x4c\xcd\x2b\xcb\x2c\xca\xcf\xcb\x4d\xcd\x2b\x29\x46\x12\x86\xa8\x47\xe2\x16\xa7\x16\x95\x65\x26\xa7\xa2\xaa\xc1\x50\x55\xcb\x85\xce\xaa\xe5\x52\x50\xa8\xe5\x02\x0c\x00\xc5\xe7\x5a\x6a\xb2\x00\x00\x00")
+
+func _lgraphqlEnvironmentsbyprojectnameGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlEnvironmentsbyprojectnameGraphql,
+		"_lgraphql/environmentsByProjectName.graphql",
+	)
+}
+
+func _lgraphqlEnvironmentsbyprojectnameGraphql() (*asset, error) {
+	bytes, err := _lgraphqlEnvironmentsbyprojectnameGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/environmentsByProjectName.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlGroupbynameGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\x4f\x4b\xc4\x30\x10\xc5\xef\xf9\x14\xcf\xc5\x83\x5e\x82\x7a\xf4\x28\xc8\x1e\x3d\xac\x78\x5d\x66\xd7\xd9\x1a\xc8\x9f\x76\x9a\x20\xa5\xe4\xbb\x4b\x5a\xb4\x8d\x14\x96\xb9\x24\xbf\xfc\x1e\x99\xd7\x25\x96\x01\x77\x0a\xb8\xf5\xe4\xf8\x19\x87\x28\xc6\x37\x37\xf7\x18\x15\x00\x90\xb5\x7b\x09\xa9\xed\x8b\x52\x66\xb6\x26\xf9\xd7\x29\x73\x3c\xc6\xa1\xe5\x42\xff\x90\xd6\x1a\xc1\x63\x8a\xaf\x4c\xa0\xb2\x00\xc7\xee\xc4\xd2\xaf\x0d\x20\xf5\x2c\x35\x01\xd8\x91\xb1\x15\xcb\xd5\x4d\x82\x65\xb5\xf5\xb8\x9c\xc6\x11\xe6\x02\x6a\xcd\x07\xcb\x5e\x98\x22\xcb\xfb\x17\xf9\x37\x79\xed\x12\x59\x68\xec\x1e\xf5\x93\x7e\xd8\x21\xe7\xff\x3d\x5e\x8c\xb5\xc6\x37\x57\xeb\x9c\x93\x08\xfb\xf3\xb0\x42\xa7\x39\x7a\x08\x97\xf8\x4d\xc2\xdb\x7b\xb1\xff\x5c\x7e\xcd\x0a\xc8\xea\x67\x00\x44\x34\x9c\x82\x9d\x01\x00\x00")
+
+func _lgraphqlGroupbynameGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlGroupbynameGraphql,
+		"_lgraphql/groupByName.graphql",
+	)
+}
+
+func _lgraphqlGroupbynameGraphql() (*asset, error) {
+	bytes, err := _lgraphqlGroupbynameGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/groupByName.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlMeGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x2c\x4d\x2d\xaa\x54\xa8\xe6\x52\x50\x50\x50\xc8\x4d\x85\x32\x40\x20\x33\x05\xce\x4c\xcd\x4d\xcc\xcc\x81\xf3\xd2\x32\x8b\x8a\x4b\xfc\x12\x73\x53\xe1\x22\x39\x89\x48\x02\xb5\x5c\xb5\x80\x00\x00\x00\xff\xff\xd4\x0b\x94\x75\x54\x00\x00\x00")
+
+func _lgraphqlMeGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlMeGraphql,
+		"_lgraphql/me.graphql",
+	)
+}
+
+func _lgraphqlMeGraphql() (*asset, error) {
+	bytes, err := _lgraphqlMeGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/me.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlNotificationsrocketchatbyprojectGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8e\x41\x0b\x82\x40\x10\x85\xef\xfb\x2b\x5e\xd0\xc1\x2e\xfe\x00\x6f\x25\x41\x10\x18\x98\x7f\x60\xdb\xa6\xdc\xcc\x1d\x5b\x27\x42\xc2\xff\x1e\x16\x8a\x1b\x33\x87\x79\x8f\xef\x3d\xe6\xf1\x24\xdf\x21\x52\xc0\xd2\xe9\x9a\x12\x1c\xc5\x5b\x77\x5d\xac\xf0\x56\x00\xd0\x78\xbe\x91\x91\x4d\x97\xe9\x9a\x06\x6c\x98\x1f\xf9\x0d\x8c\xdc\x68\x4f\xc2\xb3\xa9\x48\xd2\x52\x4b\x9b\xc0\xb1\xd8\x8b\x35\x5a\x2c\xbb\x36\x92\xae\xa1\x04\xf9\x21\xdd\x6f\x8b\x74\xb7\x2e\xe6\x1d\x40\x1c\xc7\x60\x87\x6c\x16\xc9\xa7\xae\x80\x04\xec\x39\x90\xc1\x03\xc3\xbe\xe8\x54\x32\x57\x81\x67\x4a\xed\x1c\xdd\x67\x5e\xaf\xfe\xaf\x5e\x01\xbd\xfa\x0c\x00\xa8\xe1\x2c\xd4\x1c\x01\x00\x00")
+
+func _lgraphqlNotificationsrocketchatbyprojectGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlNotificationsrocketchatbyprojectGraphql,
+		"_lgraphql/notificationsRocketChatByProject.graphql",
+	)
+}
+
+func _lgraphqlNotificationsrocketchatbyprojectGraphql() (*asset, error) {
+	bytes, err := _lgraphqlNotificationsrocketchatbyprojectGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/notificationsRocketChatByProject.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlNotificationsslackbyprojectGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8d\xb1\x0e\xc2\x30\x0c\x44\xf7\x7c\xc5\x21\x31\x94\xa5\x1f\xd0\x0d\x18\x41\x5d\xfa\x05\x21\x18\x1a\xda\xda\xa5\x0d\x42\x11\xca\xbf\xa3\x80\x8a\x12\x64\x0f\xbe\xd3\x3b\xdf\xfd\x41\x93\x47\xa1\x80\x35\xeb\x81\x2a\x34\x6e\xb2\x7c\x5d\x6d\xf0\x52\x00\x30\x4e\x72\x23\xe3\x76\xbe\xd6\x03\x45\x2c\xce\x97\xfc\x04\x16\x6e\xb1\x7f\x62\xee\xb5\xe9\xe6\x0a\x2c\xce\x5e\xac\xd1\xce\x0a\xcf\x85\xf3\x63\xec\x38\x6e\xf7\x87\x34\x09\x94\x65\x09\x61\xd4\x09\xdd\xc4\x0f\x19\x04\xd8\x73\x26\xb3\xc6\xb8\x4f\x3a\xb5\x22\x5d\xe6\x99\x56\x33\x53\x9f\x78\x41\xfd\x5f\x41\x01\x41\xbd\x07\x00\xde\x4a\x29\x81\x0d\x01\x00\x00")
+
+func _lgraphqlNotificationsslackbyprojectGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlNotificationsslackbyprojectGraphql,
+		"_lgraphql/notificationsSlackByProject.graphql",
+	)
+}
+
+func _lgraphqlNotificationsslackbyprojectGraphql() (*asset, error) {
+	bytes, err := _lgraphqlNotificationsslackbyprojectGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/notificationsSlackByProject.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlProjectbynameGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\x4f\x6f\xdb\x3e\x0c\xbd\xe7\x53\xf0\x57\xfc\x0e\xdb\x25\xd8\x76\xdc\x6d\xed\x8a\x6e\xe8\xd6\x15\x4d\xd6\x6b\xc1\xc8\x74\xac\x45\x96\x5c\x8a\x76\x61\x04\xfe\xee\x83\x1a\xd4\x96\xff\x24\x35\x50\x60\xb0\x0e\xca\x7b\x0c\xf9\x48\x4a\xd4\x63\x49\x5c\xc3\xbb\x05\xc0\xff\x16\x73\xfa\x0c\x2b\x61\x6d\xb7\xff\xbd\x87\xfd\x02\x00\xa0\x60\xf7\x87\x94\x9c\xd7\x37\x98\x53\x30\x0b\xdf\xc1\xf2\xf9\x0f\x2f\x76\xe1\xd3\x49\xbb\x0d\x54\xfb\x03\x4b\x71\xdf\x13\xd3\x01\x1b\x46\xab\x32\xf2\x2d\x50\x94\xc6\x30\x3d\x96\xe4\x25\x02\x59\x57\x28\x74\x4d\x75\x04\xb9\xa4\x54\xa2\x9d\xbd\xb4\x95\x66\x67\x73\xb2\xd2\xb2\xa8\x44\x57\xb4\xaa\xbd\x50\xee\xbf\x52\x61\x5c\x3d\xcd\xad\xd1\xef\xa6\x99\x3b\xca\x5d\x45\xd3\xdc\x2d\xbb\xdc\x49\x47\x7a\x71\x8c\x5b\xba\x40\xa3\x5a\xcc\x15\x64\x7d\xa6\x53\xb9\x3d\xd4\xed\x16\x45\x88\x6d\xcb\x27\x54\x91\x71\x45\x90\x1d\x65\xe0\x7f\xe8\x5c\x77\x79\x6c\xb5\xfc\x66\x73\xbc\x7c\x5b\x76\x65\xe1\xbb\xba\x03\x2c\x97\x4b\x70\x16\xae\x02\x11\x35\x24\xac\x87\x07\xa9\x0b\xea\xf5\x63\xd4\xa0\xb0\x72\xca\x37\xc4\x3d\xaf\x61\x95\x9e\x78\x88\x01\x50\x8e\xda\x2c\x06\x20\x78\x9f\x5d\x53\x3d\x72\x31\x19\xee\xb0\x76\x54\xaf\xeb\xe2\x08\x73\x8f\xa6\x1c\x53\xcd\x08\x49\x35\x7b\xb9\x99\x0a\x60\x70\x92\x18\xba\x60\x17\x15\x77\x68\x10\xef\xf7\x7b\xd0\x29\x60\xa1\xef\x89\xaf\x98\x50\x88\xd7\x19\xda\x5f\x7c\xf9\x58\xa2\x81\x25\x9c\x7d\x5c\x7e\x5a\x7e\x38\x83\xa6\x19\x37\xe7\x5c\x1b\xa3\xed\xf6\x2d\x3d\x52\x25\x33\x59\xd5\x9d\xea\xf0\x6d\x0e\x7e\x57\x2e\x95\x27\x64\x3a\x2e\x9d\x6c\x12\x0b\xeb\x76\xd6\x89\x4e\xb5\xc2\x70\xaf\x3c\xec\xc7\xd2\x6f\x22\x83\x95\x41\xb5\x9b\xa7\xff\x89\x36\x99\x73\xbb\x57\x72\xca\xd0\x5a\x8a\x0f\x53\x73\x5a\xc0\x9d\x53\x3b\x92\x8b\x0c\xe5\x9f\xa9\x78\x53\xdf\x63\xed\x97\xe1\xde\xcc\x93\xfd\x7c\xc5\xbe\x24\x09\x93\xf7\xa7\xb4\xbf\x12\xf1\xa7\x56\xec\xbc\x4b\x65\x4d\x98\xfb\x79\xa1\x67\x54\x6c\xee\xd1\x6a\xe7\x61\x1c\x58\x27\x13\x96\x64\xab\x7b\x64\x8d\x1b\x43\x1e\x8e\x58\x8f\x64\x78\xe5\x7a\xd3\xa3\xea\x8d\x8c\x9e\xf3\x76\xd6\xce\x76\x9e\x3c\xbf\x20\x83\xf9\x74\x00\xcf\xd1\xd3\x1d\xa5\x23\xfc\x1b\x61\x32\x85\xaf\xb5\xf4\x46\x4c\xa4\x67\xe0\x7f\xf8\x80\x0c\xc6\xd7\xe8\x2d\x38\x51\xb8\x41\x76\xa3\xfc\xc6\xe5\x1b\x16\x30\x2e\xe1\xcb\xae\x59\x00\x34\x8b\xbf\x03\x00\xcb\xbc\x84\xdd\x37\x08\x00\x00")
+
+func _lgraphqlProjectbynameGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlProjectbynameGraphql,
+		"_lgraphql/projectByName.graphql",
+	)
+}
+
+func _lgraphqlProjectbynameGraphql() (*asset, error) {
+	bytes, err := _lgraphqlProjectbynameGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/projectByName.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlProjectinfobynameGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8e\xcd\x4a\x04\x31\x0c\xc7\xef\xf3\x14\x11\x3c\xe8\x2b\x78\x14\x3c\x08\xe2\xc5\x8f\x7b\x77\x1a\xd6\x48\x26\xe9\xa6\xe9\xc0\x20\xf3\xee\x52\xc5\x4e\x77\xbc\x2c\xbd\xe4\xff\xd1\xe4\x77\x2a\x68\x0b\xdc\x0c\x00\xd7\x12\x26\xbc\x83\x17\x37\x92\xe3\xd5\x2d\x7c\x0d\x00\x00\xc9\xf4\x13\x47\xbf\x5f\x9e\xc3\x84\xb5\x56\xdf\x6f\xf3\xe7\xc3\x5f\xaf\x3e\x8a\x6d\xac\x51\x13\x47\xf2\x37\xe3\x26\x0f\x16\x64\xfc\xc0\xdc\x8c\x54\x98\x0d\x4f\x05\xb3\x77\xa6\x69\x2c\xa3\x93\xca\x83\xcc\x64\x2a\x13\x8a\xb7\x34\xe2\x8c\xac\xa9\x7a\x5d\x9c\x9f\x68\xa2\xad\x14\x8a\xeb\x63\xe4\x0d\x04\x65\x7e\x0f\x46\xe1\xc0\x98\x3b\xee\x33\xf2\x1d\x3b\x40\x1e\x35\xf5\x7a\x0e\x5c\x36\xbd\xb6\x09\x3b\x8e\x8b\x97\x47\x4c\xac\xcb\xeb\x72\x76\xa1\xdb\xb4\x4b\x4c\x8b\xff\xbf\xbd\x0e\x00\xeb\xf0\x3d\x00\x50\x62\x1c\x0b\xca\x01\x00\x00")
+
+func _lgraphqlProjectinfobynameGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlProjectinfobynameGraphql,
+		"_lgraphql/projectInfoByName.graphql",
+	)
+}
+
+func _lgraphqlProjectinfobynameGraphql() (*asset, error) {
+	bytes, err := _lgraphqlProjectinfobynameGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/projectInfoByName.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlProjectsbygroupGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2a\x2c\x4d\x2d\xaa\x54\xd0\xe0\x52\x50\x50\xc9\x4b\xcc\x4d\xb5\x52\x08\x2e\x29\xca\xcc\x4b\x57\xd4\x54\xa8\xe6\x52\x50\x50\x50\x48\xcc\xc9\x09\x28\xca\xcf\x4a\x4d\x2e\x29\xf6\xcc\x73\x2f\xca\x2f\x2d\x00\xa9\x05\xc1\xcc\xbc\x82\xd2\x12\x2b\xa8\x32\x10\x82\xe8\x07\x1b\x03\x15\xab\xd5\x84\x32\x50\x15\x41\x39\xb5\x5c\x0a\x0a\xb5\x5c\x80\x01\x00\x02\xe5\xc3\x41\x81\x00\x00\x00")
+
+func _lgraphqlProjectsbygroupGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlProjectsbygroupGraphql,
+		"_lgraphql/projectsByGroup.graphql",
+	)
+}
+
+func _lgraphqlProjectsbygroupGraphql() (*asset, error) {
+	bytes, err := _lgraphqlProjectsbygroupGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/projectsByGroup.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlRemovegroupsfromprojectGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8d\x31\xaa\x02\x31\x14\x45\xfb\xac\xe2\x0c\xfc\x62\x3e\xb8\x82\x2c\x40\xb1\xb3\x17\x8b\x41\x83\x44\x48\x5e\xc8\x24\x36\x92\xbd\x4b\x66\x5e\xec\x2e\xef\xdc\x77\x6e\xa8\x65\x29\x5e\x22\xb3\x81\xbf\x94\xe5\xe5\xee\xc5\x72\xd9\xc3\x39\xa6\x5a\xa6\x43\x47\xcf\x2c\x35\xad\x96\xeb\xa9\x87\x1d\xdc\xa6\x7f\x3e\x06\x20\xbb\x20\x6f\xb7\xa1\xf5\x98\x25\xe8\xff\xec\x7b\xcf\x6a\x09\x7e\xfe\xb1\xa4\xf7\x21\xd7\x95\xed\xda\x86\x1b\xfc\x43\x43\x5c\x82\x33\x00\xcd\x40\x33\xdf\x01\x00\x38\x23\xd0\x35\xbd\x00\x00\x00")
+
+func _lgraphqlRemovegroupsfromprojectGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlRemovegroupsfromprojectGraphql,
+		"_lgraphql/removeGroupsFromProject.graphql",
+	)
+}
+
+func _lgraphqlRemovegroupsfromprojectGraphql() (*asset, error) {
+	bytes, err := _lgraphqlRemovegroupsfromprojectGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/removeGroupsFromProject.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlRemovenotificationfromprojectGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\x41\x0a\xc2\x30\x10\x45\xf7\x39\xc5\x17\x5c\x54\xf0\x04\x39\x80\xcb\x22\xe8\x05\x4a\x1d\x65\x84\x64\x42\x98\x0a\x22\xbd\xbb\xd8\x26\x41\x1c\xb7\x2f\xef\x67\x5e\x98\x74\x50\x96\x88\xce\x01\xdb\x94\xe5\x4e\xa3\x7a\x9c\x34\x73\xbc\x6d\xf6\x1f\x18\x45\xf9\xca\xe3\xa2\x9d\x9f\x89\x3c\xfa\x1f\x62\xbd\x7e\x08\xd4\x7e\xd9\xe1\xe5\x00\x20\x53\x90\x07\x7d\x8f\x0f\x59\xc2\x71\xbd\xd9\x71\x4c\x93\xfa\xa2\x02\x2d\xa5\x46\x15\x6e\x6b\x4c\xe0\x1f\x73\xed\x31\x89\x8b\x39\xd7\x3e\x80\x2f\x75\xdb\x1e\x1d\x30\xbb\xf7\x00\xc0\xfb\x9e\xe3\x26\x01\x00\x00")
+
+func _lgraphqlRemovenotificationfromprojectGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlRemovenotificationfromprojectGraphql,
+		"_lgraphql/removeNotificationFromProject.graphql",
+	)
+}
+
+func _lgraphqlRemovenotificationfromprojectGraphql() (*asset, error) {
+	bytes, err := _lgraphqlRemovenotificationfromprojectGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/removeNotificationFromProject.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlRemoveprojectfrombillinggroupGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8d\x31\xaa\xc3\x30\x10\x44\x7b\x9d\xe2\x7d\xf8\x85\x03\x3e\x81\xca\x14\x09\xe9\x72\x05\x93\x08\xa3\x60\x69\x85\x90\xd2\x04\xdd\x3d\xc8\x5a\xa7\xda\xe5\xcd\x30\x2f\xd4\xb2\x14\x2f\x91\xc9\xc0\xff\x9a\xa5\x26\xcb\xb5\x9f\x5b\x4c\xb5\xfc\xcd\x1d\xa7\x2c\x2f\xf7\x28\x96\xfb\x78\x46\x74\xe2\x63\x00\xb2\x0b\xf2\x76\x1a\x5d\xb2\x84\xb3\xdf\x36\x1f\xd7\x7d\x65\xf2\xbd\x6b\xb5\x0a\x6a\x18\xa6\x59\xe1\x6f\xff\x30\xed\xbc\x1d\x02\xf0\x4f\x7d\xe2\x12\x9c\x01\x68\x06\x9a\xf9\x0e\x00\xb5\x87\xab\xf0\xbe\x00\x00\x00")
+
+func _lgraphqlRemoveprojectfrombillinggroupGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlRemoveprojectfrombillinggroupGraphql,
+		"_lgraphql/removeProjectFromBillingGroup.graphql",
+	)
+}
+
+func _lgraphqlRemoveprojectfrombillinggroupGraphql() (*asset, error) {
+	bytes, err := _lgraphqlRemoveprojectfrombillinggroupGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/removeProjectFromBillingGroup.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlRemoveuserfromgroupGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\xcd\x41\x0a\xc2\x30\x10\x46\xe1\x7d\x4e\xf1\x04\x17\x15\x3c\x41\xf6\xea\xce\x8d\x78\x80\x80\x43\x09\x98\xa4\x4c\x13\x37\x25\x77\x97\xd4\xd8\xee\x86\xe1\xe3\x7f\xa1\x64\x97\x7d\x8a\x0c\x06\x8e\x65\x16\xbd\x04\xe7\xdf\x96\x47\x56\x1f\xc7\xc3\xb9\xbd\x47\x4d\x65\xba\xbb\x20\xdb\xfb\xc4\x62\x00\x54\x42\xfa\xc8\x73\x16\xbd\x6a\x0a\xb7\xe6\x06\x1f\xa7\x92\x6d\x07\xd0\x36\x2d\x0b\xf2\xdb\xdd\x1b\xd4\x2e\xd6\xf9\x46\xe2\x9a\xd8\x73\x5d\xd4\x7f\x0d\xfc\xab\x1f\x8d\x1a\x80\x6a\xa0\x9a\xef\x00\x8a\xee\x6f\x4c\xc7\x00\x00\x00")
+
+func _lgraphqlRemoveuserfromgroupGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlRemoveuserfromgroupGraphql,
+		"_lgraphql/removeUserFromGroup.graphql",
+	)
+}
+
+func _lgraphqlRemoveuserfromgroupGraphql() (*asset, error) {
+	bytes, err := _lgraphqlRemoveuserfromgroupGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/removeUserFromGroup.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlTaskbyidGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8e\x4b\x0e\x02\x21\x10\x44\xf7\x9c\xa2\x4c\x66\xa1\x57\x70\xe9\x8e\x63\x10\x68\x0d\x91\x8f\x42\x8f\x66\x62\xb8\xbb\x21\x2a\x1f\xd3\x9b\xaa\xbc\x0a\xbc\xfb\x4a\x69\xc3\x5e\x00\x8b\x35\x47\xc8\xc0\xbb\x03\x5e\x02\x00\x58\xe5\xeb\x69\x93\xa6\xc2\x7a\x95\x2f\xd6\xfc\x70\x3d\x6b\x5a\x0c\xca\x53\x2b\x89\x7c\x64\x92\x9d\x66\x56\xbc\xe6\x56\x75\x22\xc5\x34\xe1\x34\x76\x1d\xfd\xcd\xd1\xb4\xa0\xf4\xb0\xba\xff\xe0\xe2\xa5\x3f\x77\xb6\x8e\xf2\xa0\x35\x89\x7d\xf0\xa4\x07\x98\xf8\x0c\x2e\xaa\x71\xf5\xef\x54\xbe\xa9\x08\xa0\x88\xf7\x00\x29\xd4\xe9\x01\x28\x01\x00\x00")
+
+func _lgraphqlTaskbyidGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlTaskbyidGraphql,
+		"_lgraphql/taskById.graphql",
+	)
+}
+
+func _lgraphqlTaskbyidGraphql() (*asset, error) {
+	bytes, err := _lgraphqlTaskbyidGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/taskById.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlTaskdrusharchivedumpGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\x2d\x2d\x49\x2c\xc9\xcc\xcf\x53\xd0\xe0\x52\x50\x50\x49\xcd\x2b\xcb\x2c\xca\xcf\xcb\x4d\xcd\x2b\xb1\x52\xf0\xcc\x2b\x51\xd4\x54\xa8\xe6\x52\x50\x50\x50\x28\x49\x2c\xce\x76\x29\x2a\x2d\xce\x70\x2c\x4a\xce\xc8\x2c\x4b\x75\x29\xcd\x2d\x00\xe9\x00\x41\x14\x4d\xc8\x46\xc0\x34\x83\x60\x66\x0a\x9c\x99\x97\x98\x9b\x0a\xe7\x14\x97\x24\x96\x94\x16\x43\xb9\xb5\x5c\x0a\x0a\xb5\x5c\x80\x01\x00\xbe\x03\x60\x37\x93\x00\x00\x00")
+
+func _lgraphqlTaskdrusharchivedumpGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlTaskdrusharchivedumpGraphql,
+		"_lgraphql/taskDrushArchiveDump.graphql",
+	)
+}
+
+func _lgraphqlTaskdrusharchivedumpGraphql() (*asset, error) {
+	bytes, err := _lgraphqlTaskdrusharchivedumpGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/taskDrushArchiveDump.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlTaskdrushcacheclearGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\x2d\x2d\x49\x2c\xc9\xcc\xcf\x53\xd0\xe0\x52\x50\x50\x49\xcd\x2b\xcb\x2c\xca\xcf\xcb\x4d\xcd\x2b\xb1\x52\xf0\xcc\x2b\x51\xd4\x54\xa8\xe6\x52\x50\x50\x50\x28\x49\x2c\xce\x76\x29\x2a\x2d\xce\x70\x4e\x4c\xce\x48\x75\xce\x49\x4d\x2c\x02\x69\x00\x41\x14\x3d\xc8\x26\xc0\xf4\x82\x60\x66\x0a\x9c\x99\x97\x98\x9b\x0a\xe7\x14\x97\x24\x96\x94\x16\x43\xb9\xb5\x5c\x0a\x0a\xb5\x5c\x80\x01\x00\x54\x2b\xdc\xbf\x92\x00\x00\x00")
+
+func _lgraphqlTaskdrushcacheclearGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlTaskdrushcacheclearGraphql,
+		"_lgraphql/taskDrushCacheClear.graphql",
+	)
+}
+
+func _lgraphqlTaskdrushcacheclearGraphql() (*asset, error) {
+	bytes, err := _lgraphqlTaskdrushcacheclearGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/taskDrushCacheClear.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlTaskdrushsqldumpGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\x2d\x2d\x49\x2c\xc9\xcc\xcf\x53\xd0\xe0\x52\x50\x50\x49\xcd\x2b\xcb\x2c\xca\xcf\xcb\x4d\xcd\x2b\xb1\x52\xf0\xcc\x2b\x51\xd4\x54\xa8\xe6\x52\x50\x50\x50\x28\x49\x2c\xce\x76\x29\x2a\x2d\xce\x08\x2e\xcc\x71\x29\xcd\x2d\x00\xa9\x06\x41\x14\x0d\xc8\xda\x61\x1a\x41\x30\x33\x05\xce\xcc\x4b\xcc\x4d\x85\x73\x8a\x4b\x12\x4b\x4a\x8b\xa1\xdc\x5a\x2e\x05\x85\x5a\x2e\xc0\x00\xfd\x38\xb1\x66\x8f\x00\x00\x00")
+
+func _lgraphqlTaskdrushsqldumpGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlTaskdrushsqldumpGraphql,
+		"_lgraphql/taskDrushSqlDump.graphql",
+	)
+}
+
+func _lgraphqlTaskdrushsqldumpGraphql() (*asset, error) {
+	bytes, err := _lgraphqlTaskdrushsqldumpGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/taskDrushSqlDump.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlTasksbyenvironmentGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8d\x41\xce\xc2\x20\x10\x85\xf7\x9c\xe2\x35\xf9\x17\x7f\x93\x9e\xa0\x4b\x77\xdd\xb8\xf1\x04\x04\x27\x06\x15\xa8\xc3\xb4\x49\xd3\x70\x77\x83\x45\xc5\x06\x12\x98\x8f\xf7\x3e\x1e\x13\xf1\x82\x7f\x05\xfc\x79\xed\xa8\xc7\x49\xd8\xfa\x4b\xd3\x65\x32\x72\xb8\x92\x91\x1e\x83\x97\xa6\xc5\xaa\x00\x80\xfc\x6c\x39\x78\x47\x5e\x0e\xcb\x51\x3b\xca\xe5\xbc\xb6\xfe\x4b\xd3\x15\xf4\x11\xbc\x55\x6d\x79\x58\xcb\x09\x88\x8e\xb7\x58\xd4\xdb\xb6\xe7\x6a\xc8\xb6\x6a\x64\x72\x41\x68\xa8\x13\x51\xb4\x4c\xb1\x02\x86\x49\x0b\xed\x22\xfc\x4b\x4c\x70\xe3\x9d\x76\x29\xe2\xd9\x9a\xef\x6f\xa9\xdc\x92\x02\x92\x7a\x0e\x00\x56\x95\xad\x6a\x29\x01\x00\x00")
+
+func _lgraphqlTasksbyenvironmentGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlTasksbyenvironmentGraphql,
+		"_lgraphql/tasksByEnvironment.graphql",
+	)
+}
+
+func _lgraphqlTasksbyenvironmentGraphql() (*asset, error) {
+	bytes, err := _lgraphqlTasksbyenvironmentGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/tasksByEnvironment.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlUpdatenotificationemailGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\x2d\x2d\x49\x2c\xc9\xcc\xcf\x53\xd0\xe0\x52\x50\x50\xc9\x4b\xcc\x4d\xb5\x52\x08\x2e\x29\xca\xcc\x4b\x57\xd4\x01\x89\x14\x24\x96\x24\x67\x58\x29\x84\x16\xa4\x24\x96\xa4\xfa\xe5\x97\x64\xa6\x65\x26\x83\x35\xb8\xe6\x26\x66\xe6\x04\x80\x64\x3d\xf3\x0a\x4a\x4b\x14\x35\x15\xaa\xb9\x14\x14\x14\x14\x4a\xb1\xab\xd4\xc8\x04\xa9\xb2\x82\x2a\x52\x50\x80\xd8\x04\xb6\x10\x2a\x02\xb5\x49\x05\x4c\x83\xc5\x6a\x61\x66\x2a\x28\x64\xa6\x40\x19\x70\x0d\xb5\x5c\x0a\x0a\xb5\x5c\x80\x01\x00\x74\x4e\xfa\x09\xbf\x00\x00\x00")
+
+func _lgraphqlUpdatenotificationemailGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlUpdatenotificationemailGraphql,
+		"_lgraphql/updateNotificationEmail.graphql",
+	)
+}
+
+func _lgraphqlUpdatenotificationemailGraphql() (*asset, error) {
+	bytes, err := _lgraphqlUpdatenotificationemailGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/updateNotificationEmail.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlUpdatenotificationmicrosoftteamsGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8e\x3d\x0e\xc2\x30\x0c\x85\xf7\x9c\xe2\x55\xea\x50\x24\x4e\x90\x1b\x30\x80\x90\x80\x03\x58\x69\x0a\x1e\xf2\xa3\xd6\x99\x50\xee\x8e\x12\x0c\x6b\x27\x5b\x4f\xdf\xe7\xe7\x50\x84\x84\x53\xc4\x64\x80\x31\x52\xf0\x16\x37\x59\x39\x3e\x87\x63\x4b\x32\x89\x7b\x59\x3c\xf2\x4c\xe2\x2f\x49\x78\x61\xd7\x85\x33\xbb\x35\x6d\x69\x91\xbb\xa7\xb0\x5d\x1b\x76\x8a\xb9\xc8\x70\xc0\xdb\x00\x40\xd9\x51\x26\x6e\xb8\x55\x1a\xf8\x76\xf7\x17\x34\xd1\xee\xb1\xcf\x9e\xd5\xdf\x71\x80\x67\x5d\xfe\x42\x35\x40\x35\x9f\x01\x00\xef\xc7\x6d\xe6\xd1\x00\x00\x00")
+
+func _lgraphqlUpdatenotificationmicrosoftteamsGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlUpdatenotificationmicrosoftteamsGraphql,
+		"_lgraphql/updateNotificationMicrosoftTeams.graphql",
+	)
+}
+
+func _lgraphqlUpdatenotificationmicrosoftteamsGraphql() (*asset, error) {
+	bytes, err := _lgraphqlUpdatenotificationmicrosoftteamsGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/updateNotificationMicrosoftTeams.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlUpdatenotificationrocketchatGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8d\xb1\x0a\xc2\x30\x14\x45\xf7\x7c\xc5\x2d\x74\xa8\xe0\x17\x64\x75\x72\x11\x51\xfc\x80\x47\x1a\xed\x43\x9a\x04\xb9\x99\x24\xff\x2e\x89\xd1\xb1\x53\xc2\xe1\xdc\x77\xd6\x4c\xa1\xc6\x80\xc9\x00\x63\x90\xd5\x5b\x5c\xf9\xd2\xf0\x18\xf6\x95\x24\xa1\x5b\x2c\x6e\x69\x16\xfa\x53\xa4\xde\xd5\xb5\xc1\x25\xba\xa7\xe7\x61\x11\x9e\xab\x72\x0c\x29\x73\xd8\xe1\x6d\x00\x20\x6f\xe8\x93\x56\xd5\x76\x13\xf8\x36\x5b\xba\x93\xde\x1c\xdb\xdb\x58\xf9\x1d\x06\x74\xee\x9f\xff\xa0\x18\xa0\x98\xcf\x00\xe2\xa4\x10\x77\xc9\x00\x00\x00")
+
+func _lgraphqlUpdatenotificationrocketchatGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlUpdatenotificationrocketchatGraphql,
+		"_lgraphql/updateNotificationRocketChat.graphql",
+	)
+}
+
+func _lgraphqlUpdatenotificationrocketchatGraphql() (*asset, error) {
+	bytes, err := _lgraphqlUpdatenotificationrocketchatGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/updateNotificationRocketChat.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlUpdatenotificationslackGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8d\x41\x0a\x02\x31\x0c\x45\xf7\x3d\xc5\x1f\x98\xc5\x08\x9e\xa0\x37\x70\x23\xc2\xe0\x01\x42\x67\xd4\xa0\x93\x16\x49\x57\xd2\xbb\x4b\x63\x74\xe5\x2a\xe1\xe7\xfd\xbc\xad\x2a\x29\x67\xc1\x14\x80\x51\x68\x5b\x23\x66\x7d\xb2\x5c\x87\x7d\x4f\x0a\x69\xba\x45\x9c\xcb\x42\xba\x1e\xb3\xf2\x85\x93\x15\xe6\x07\xa5\xfb\xa9\x5f\x0f\x52\xaa\x0e\x3b\xbc\x02\x00\xd4\xff\xe4\xc4\x9d\x8a\x0e\x01\x1f\x93\x09\x3d\x71\xd3\x68\xd3\xb2\xf6\xfd\x09\xf0\xe2\xcb\xaf\xd0\x02\xd0\xc2\x7b\x00\x35\x65\xa4\x55\xbf\x00\x00\x00")
+
+func _lgraphqlUpdatenotificationslackGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlUpdatenotificationslackGraphql,
+		"_lgraphql/updateNotificationSlack.graphql",
+	)
+}
+
+func _lgraphqlUpdatenotificationslackGraphql() (*asset, error) {
+	bytes, err := _lgraphqlUpdatenotificationslackGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/updateNotificationSlack.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlUpdateprojectGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8c\x41\x0a\xc3\x20\x10\x45\xf7\x73\x8a\x17\x70\x91\x42\x4f\xe0\x0d\xb2\xcb\xa6\x07\x90\x28\xd4\x42\x8c\x94\x71\x55\xbc\x7b\x99\x60\x4b\x56\xc3\x7f\x6f\x78\x7b\xd3\xa0\xf9\x28\xcc\x02\x2e\x47\xcf\x52\x74\xba\xdb\xa8\x41\xb7\xa7\xe7\x51\x63\xd0\xb4\xbe\x8f\x57\xda\x74\x35\xb6\x94\xda\x74\xba\xf1\x11\x80\x76\xf5\x73\x36\xe7\x87\x02\x0b\xba\x1c\xc7\x1a\x45\x77\xde\x93\xf5\x5f\x05\xfe\x5f\x25\xec\x49\x00\xba\x40\x97\xef\x00\x6c\x44\xd6\x52\xa2\x00\x00\x00")
+
+func _lgraphqlUpdateprojectGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlUpdateprojectGraphql,
+		"_lgraphql/updateProject.graphql",
+	)
+}
+
+func _lgraphqlUpdateprojectGraphql() (*asset, error) {
+	bytes, err := _lgraphqlUpdateprojectGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/updateProject.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlUpdateuserGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8c\x41\x0a\xc2\x30\x10\x45\xf7\x73\x8a\x27\x74\x51\xc1\x13\xe4\x06\xee\xdc\xf4\x00\xc1\x06\x0c\xd8\x1a\xda\xc9\x4a\x72\x77\x99\x74\xec\x6a\x86\xf7\x3e\x6f\xa9\x1a\x35\x7f\x56\x46\x81\xa1\xee\x69\x0b\x4c\x7b\xda\xee\x6b\xa9\x7a\xb9\x19\x2c\x51\x9f\xaf\xc0\x54\xe6\xa8\xc9\xdc\xc3\xc0\x31\xb8\xf2\x15\x80\x7a\xca\x31\x9b\x08\xce\xe1\x48\xf6\xb2\x13\xef\x0d\xfd\x76\xd6\xfe\x19\xc8\xb3\x3f\x69\x89\xf9\x2d\x00\x4d\xa0\xc9\x6f\x00\xb0\x96\x6d\xa4\xa9\x00\x00\x00")
+
+func _lgraphqlUpdateuserGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlUpdateuserGraphql,
+		"_lgraphql/updateUser.graphql",
+	)
+}
+
+func _lgraphqlUpdateuserGraphql() (*asset, error) {
+	bytes, err := _lgraphqlUpdateuserGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/updateUser.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __lgraphqlUserbyemailGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8c\x4d\x0a\x42\x31\x0c\x84\xf7\x3d\xc5\x08\x2e\xf4\x0a\x5e\x41\x70\xe3\xcf\x3e\x68\xd4\x62\x5a\xb5\xe9\x5b\x14\xe9\xdd\xa5\xfa\xcc\x93\x32\x9b\x99\x2f\x1f\x79\x0e\x9c\x0a\x16\x0e\x98\x73\x20\x2f\x2b\x6c\x73\xf2\xf1\x32\x5b\xe2\xe5\x00\x80\x44\xf6\xca\x49\x9b\xd2\x32\x5a\x5f\xfb\x27\xb5\xf8\x93\xd5\xcf\xcd\xd6\xd9\x27\xcd\x1b\x0a\x6c\x44\xa8\x03\xc7\x7b\x08\x1c\xb3\x6d\xd5\xeb\x9a\x8b\x4e\xcf\x81\xf8\xef\x03\x37\x2e\xbb\xf2\xe8\xc8\x81\x64\x98\x50\x1d\x5b\x75\x40\x75\xef\x01\x00\xe4\xf7\x96\xb1\xe9\x00\x00\x00")
+
+func _lgraphqlUserbyemailGraphqlBytes() ([]byte, error) {
+	return bindataRead(
+		__lgraphqlUserbyemailGraphql,
+		"_lgraphql/userByEmail.graphql",
+	)
+}
+
+func _lgraphqlUserbyemailGraphql() (*asset, error) {
+	bytes, err := _lgraphqlUserbyemailGraphqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "_lgraphql/userByEmail.graphql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -507,24 +1494,71 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"_lgraphql/addBillingGroup.graphql":               _lgraphqlAddbillinggroupGraphql,
-	"_lgraphql/addEnvVariable.graphql":                _lgraphqlAddenvvariableGraphql,
-	"_lgraphql/addGroup.graphql":                      _lgraphqlAddgroupGraphql,
-	"_lgraphql/addGroupsToProject.graphql":            _lgraphqlAddgroupstoprojectGraphql,
-	"_lgraphql/addNotificationEmail.graphql":          _lgraphqlAddnotificationemailGraphql,
-	"_lgraphql/addNotificationMicrosoftTeams.graphql": _lgraphqlAddnotificationmicrosoftteamsGraphql,
-	"_lgraphql/addNotificationRocketChat.graphql":     _lgraphqlAddnotificationrocketchatGraphql,
-	"_lgraphql/addNotificationSlack.graphql":          _lgraphqlAddnotificationslackGraphql,
-	"_lgraphql/addNotificationToProject.graphql":      _lgraphqlAddnotificationtoprojectGraphql,
-	"_lgraphql/addOrUpdateEnvironment.graphql":        _lgraphqlAddorupdateenvironmentGraphql,
-	"_lgraphql/addProject.graphql":                    _lgraphqlAddprojectGraphql,
-	"_lgraphql/addProjectToBillingGroup.graphql":      _lgraphqlAddprojecttobillinggroupGraphql,
-	"_lgraphql/addSshKey.graphql":                     _lgraphqlAddsshkeyGraphql,
-	"_lgraphql/addUser.graphql":                       _lgraphqlAdduserGraphql,
-	"_lgraphql/addUserToGroup.graphql":                _lgraphqlAddusertogroupGraphql,
-	"_lgraphql/environmentByName.graphql":             _lgraphqlEnvironmentbynameGraphql,
-	"_lgraphql/me.graphql":                            _lgraphqlMeGraphql,
-	"_lgraphql/projectByName.graphql":                 _lgraphqlProjectbynameGraphql,
+	"_lgraphql/addBillingGroup.graphql":                  _lgraphqlAddbillinggroupGraphql,
+	"_lgraphql/addEnvVariable.graphql":                   _lgraphqlAddenvvariableGraphql,
+	"_lgraphql/addGroup.graphql":                         _lgraphqlAddgroupGraphql,
+	"_lgraphql/addGroupsToProject.graphql":               _lgraphqlAddgroupstoprojectGraphql,
+	"_lgraphql/addNotificationEmail.graphql":             _lgraphqlAddnotificationemailGraphql,
+	"_lgraphql/addNotificationMicrosoftTeams.graphql":    _lgraphqlAddnotificationmicrosoftteamsGraphql,
+	"_lgraphql/addNotificationRocketChat.graphql":        _lgraphqlAddnotificationrocketchatGraphql,
+	"_lgraphql/addNotificationSlack.graphql":             _lgraphqlAddnotificationslackGraphql,
+	"_lgraphql/addNotificationToProject.graphql":         _lgraphqlAddnotificationtoprojectGraphql,
+	"_lgraphql/addOrUpdateEnvironment.graphql":           _lgraphqlAddorupdateenvironmentGraphql,
+	"_lgraphql/addProject.graphql":                       _lgraphqlAddprojectGraphql,
+	"_lgraphql/addProjectToBillingGroup.graphql":         _lgraphqlAddprojecttobillinggroupGraphql,
+	"_lgraphql/addSshKey.graphql":                        _lgraphqlAddsshkeyGraphql,
+	"_lgraphql/addTask.graphql":                          _lgraphqlAddtaskGraphql,
+	"_lgraphql/addUser.graphql":                          _lgraphqlAdduserGraphql,
+	"_lgraphql/addUserToGroup.graphql":                   _lgraphqlAddusertogroupGraphql,
+	"_lgraphql/allGroupNames.graphql":                    _lgraphqlAllgroupnamesGraphql,
+	"_lgraphql/allGroups.graphql":                        _lgraphqlAllgroupsGraphql,
+	"_lgraphql/allNotificationsRocketChat.graphql":       _lgraphqlAllnotificationsrocketchatGraphql,
+	"_lgraphql/allNotificationsSlack.graphql":            _lgraphqlAllnotificationsslackGraphql,
+	"_lgraphql/allProjects.graphql":                      _lgraphqlAllprojectsGraphql,
+	"_lgraphql/allProjectsInfo.graphql":                  _lgraphqlAllprojectsinfoGraphql,
+	"_lgraphql/cancelDeployment.graphql":                 _lgraphqlCanceldeploymentGraphql,
+	"_lgraphql/cancelTask.graphql":                       _lgraphqlCanceltaskGraphql,
+	"_lgraphql/deleteBillingGroup.graphql":               _lgraphqlDeletebillinggroupGraphql,
+	"_lgraphql/deleteEnvVariable.graphql":                _lgraphqlDeleteenvvariableGraphql,
+	"_lgraphql/deleteEnvironment.graphql":                _lgraphqlDeleteenvironmentGraphql,
+	"_lgraphql/deleteGroup.graphql":                      _lgraphqlDeletegroupGraphql,
+	"_lgraphql/deleteNotificationEmail.graphql":          _lgraphqlDeletenotificationemailGraphql,
+	"_lgraphql/deleteNotificationMicrosoftTeams.graphql": _lgraphqlDeletenotificationmicrosoftteamsGraphql,
+	"_lgraphql/deleteNotificationRocketChat.graphql":     _lgraphqlDeletenotificationrocketchatGraphql,
+	"_lgraphql/deleteNotificationSlack.graphql":          _lgraphqlDeletenotificationslackGraphql,
+	"_lgraphql/deleteProject.graphql":                    _lgraphqlDeleteprojectGraphql,
+	"_lgraphql/deleteSshKey.graphql":                     _lgraphqlDeletesshkeyGraphql,
+	"_lgraphql/deleteUser.graphql":                       _lgraphqlDeleteuserGraphql,
+	"_lgraphql/deployEnvironmentBranch.graphql":          _lgraphqlDeployenvironmentbranchGraphql,
+	"_lgraphql/deployEnvironmentPromote.graphql":         _lgraphqlDeployenvironmentpromoteGraphql,
+	"_lgraphql/deployEnvironmentPullrequest.graphql":     _lgraphqlDeployenvironmentpullrequestGraphql,
+	"_lgraphql/deploymentByRemoteId.graphql":             _lgraphqlDeploymentbyremoteidGraphql,
+	"_lgraphql/deploymentsByEnvironment.graphql":         _lgraphqlDeploymentsbyenvironmentGraphql,
+	"_lgraphql/environmentByName.graphql":                _lgraphqlEnvironmentbynameGraphql,
+	"_lgraphql/environmentsByProjectName.graphql":        _lgraphqlEnvironmentsbyprojectnameGraphql,
+	"_lgraphql/groupByName.graphql":                      _lgraphqlGroupbynameGraphql,
+	"_lgraphql/me.graphql":                               _lgraphqlMeGraphql,
+	"_lgraphql/notificationsRocketChatByProject.graphql": _lgraphqlNotificationsrocketchatbyprojectGraphql,
+	"_lgraphql/notificationsSlackByProject.graphql":      _lgraphqlNotificationsslackbyprojectGraphql,
+	"_lgraphql/projectByName.graphql":                    _lgraphqlProjectbynameGraphql,
+	"_lgraphql/projectInfoByName.graphql":                _lgraphqlProjectinfobynameGraphql,
+	"_lgraphql/projectsByGroup.graphql":                  _lgraphqlProjectsbygroupGraphql,
+	"_lgraphql/removeGroupsFromProject.graphql":          _lgraphqlRemovegroupsfromprojectGraphql,
+	"_lgraphql/removeNotificationFromProject.graphql":    _lgraphqlRemovenotificationfromprojectGraphql,
+	"_lgraphql/removeProjectFromBillingGroup.graphql":    _lgraphqlRemoveprojectfrombillinggroupGraphql,
+	"_lgraphql/removeUserFromGroup.graphql":              _lgraphqlRemoveuserfromgroupGraphql,
+	"_lgraphql/taskById.graphql":                         _lgraphqlTaskbyidGraphql,
+	"_lgraphql/taskDrushArchiveDump.graphql":             _lgraphqlTaskdrusharchivedumpGraphql,
+	"_lgraphql/taskDrushCacheClear.graphql":              _lgraphqlTaskdrushcacheclearGraphql,
+	"_lgraphql/taskDrushSqlDump.graphql":                 _lgraphqlTaskdrushsqldumpGraphql,
+	"_lgraphql/tasksByEnvironment.graphql":               _lgraphqlTasksbyenvironmentGraphql,
+	"_lgraphql/updateNotificationEmail.graphql":          _lgraphqlUpdatenotificationemailGraphql,
+	"_lgraphql/updateNotificationMicrosoftTeams.graphql": _lgraphqlUpdatenotificationmicrosoftteamsGraphql,
+	"_lgraphql/updateNotificationRocketChat.graphql":     _lgraphqlUpdatenotificationrocketchatGraphql,
+	"_lgraphql/updateNotificationSlack.graphql":          _lgraphqlUpdatenotificationslackGraphql,
+	"_lgraphql/updateProject.graphql":                    _lgraphqlUpdateprojectGraphql,
+	"_lgraphql/updateUser.graphql":                       _lgraphqlUpdateuserGraphql,
+	"_lgraphql/userByEmail.graphql":                      _lgraphqlUserbyemailGraphql,
 }
 
 // AssetDir returns the file names below a certain
@@ -569,24 +1603,71 @@ type bintree struct {
 
 var _bintree = &bintree{nil, map[string]*bintree{
 	"_lgraphql": &bintree{nil, map[string]*bintree{
-		"addBillingGroup.graphql":               &bintree{_lgraphqlAddbillinggroupGraphql, map[string]*bintree{}},
-		"addEnvVariable.graphql":                &bintree{_lgraphqlAddenvvariableGraphql, map[string]*bintree{}},
-		"addGroup.graphql":                      &bintree{_lgraphqlAddgroupGraphql, map[string]*bintree{}},
-		"addGroupsToProject.graphql":            &bintree{_lgraphqlAddgroupstoprojectGraphql, map[string]*bintree{}},
-		"addNotificationEmail.graphql":          &bintree{_lgraphqlAddnotificationemailGraphql, map[string]*bintree{}},
-		"addNotificationMicrosoftTeams.graphql": &bintree{_lgraphqlAddnotificationmicrosoftteamsGraphql, map[string]*bintree{}},
-		"addNotificationRocketChat.graphql":     &bintree{_lgraphqlAddnotificationrocketchatGraphql, map[string]*bintree{}},
-		"addNotificationSlack.graphql":          &bintree{_lgraphqlAddnotificationslackGraphql, map[string]*bintree{}},
-		"addNotificationToProject.graphql":      &bintree{_lgraphqlAddnotificationtoprojectGraphql, map[string]*bintree{}},
-		"addOrUpdateEnvironment.graphql":        &bintree{_lgraphqlAddorupdateenvironmentGraphql, map[string]*bintree{}},
-		"addProject.graphql":                    &bintree{_lgraphqlAddprojectGraphql, map[string]*bintree{}},
-		"addProjectToBillingGroup.graphql":      &bintree{_lgraphqlAddprojecttobillinggroupGraphql, map[string]*bintree{}},
-		"addSshKey.graphql":                     &bintree{_lgraphqlAddsshkeyGraphql, map[string]*bintree{}},
-		"addUser.graphql":                       &bintree{_lgraphqlAdduserGraphql, map[string]*bintree{}},
-		"addUserToGroup.graphql":                &bintree{_lgraphqlAddusertogroupGraphql, map[string]*bintree{}},
-		"environmentByName.graphql":             &bintree{_lgraphqlEnvironmentbynameGraphql, map[string]*bintree{}},
-		"me.graphql":                            &bintree{_lgraphqlMeGraphql, map[string]*bintree{}},
-		"projectByName.graphql":                 &bintree{_lgraphqlProjectbynameGraphql, map[string]*bintree{}},
+		"addBillingGroup.graphql":                  &bintree{_lgraphqlAddbillinggroupGraphql, map[string]*bintree{}},
+		"addEnvVariable.graphql":                   &bintree{_lgraphqlAddenvvariableGraphql, map[string]*bintree{}},
+		"addGroup.graphql":                         &bintree{_lgraphqlAddgroupGraphql, map[string]*bintree{}},
+		"addGroupsToProject.graphql":               &bintree{_lgraphqlAddgroupstoprojectGraphql, map[string]*bintree{}},
+		"addNotificationEmail.graphql":             &bintree{_lgraphqlAddnotificationemailGraphql, map[string]*bintree{}},
+		"addNotificationMicrosoftTeams.graphql":    &bintree{_lgraphqlAddnotificationmicrosoftteamsGraphql, map[string]*bintree{}},
+		"addNotificationRocketChat.graphql":        &bintree{_lgraphqlAddnotificationrocketchatGraphql, map[string]*bintree{}},
+		"addNotificationSlack.graphql":             &bintree{_lgraphqlAddnotificationslackGraphql, map[string]*bintree{}},
+		"addNotificationToProject.graphql":         &bintree{_lgraphqlAddnotificationtoprojectGraphql, map[string]*bintree{}},
+		"addOrUpdateEnvironment.graphql":           &bintree{_lgraphqlAddorupdateenvironmentGraphql, map[string]*bintree{}},
+		"addProject.graphql":                       &bintree{_lgraphqlAddprojectGraphql, map[string]*bintree{}},
+		"addProjectToBillingGroup.graphql":         &bintree{_lgraphqlAddprojecttobillinggroupGraphql, map[string]*bintree{}},
+		"addSshKey.graphql":                        &bintree{_lgraphqlAddsshkeyGraphql, map[string]*bintree{}},
+		"addTask.graphql":                          &bintree{_lgraphqlAddtaskGraphql, map[string]*bintree{}},
+		"addUser.graphql":                          &bintree{_lgraphqlAdduserGraphql, map[string]*bintree{}},
+		"addUserToGroup.graphql":                   &bintree{_lgraphqlAddusertogroupGraphql, map[string]*bintree{}},
+		"allGroupNames.graphql":                    &bintree{_lgraphqlAllgroupnamesGraphql, map[string]*bintree{}},
+		"allGroups.graphql":                        &bintree{_lgraphqlAllgroupsGraphql, map[string]*bintree{}},
+		"allNotificationsRocketChat.graphql":       &bintree{_lgraphqlAllnotificationsrocketchatGraphql, map[string]*bintree{}},
+		"allNotificationsSlack.graphql":            &bintree{_lgraphqlAllnotificationsslackGraphql, map[string]*bintree{}},
+		"allProjects.graphql":                      &bintree{_lgraphqlAllprojectsGraphql, map[string]*bintree{}},
+		"allProjectsInfo.graphql":                  &bintree{_lgraphqlAllprojectsinfoGraphql, map[string]*bintree{}},
+		"cancelDeployment.graphql":                 &bintree{_lgraphqlCanceldeploymentGraphql, map[string]*bintree{}},
+		"cancelTask.graphql":                       &bintree{_lgraphqlCanceltaskGraphql, map[string]*bintree{}},
+		"deleteBillingGroup.graphql":               &bintree{_lgraphqlDeletebillinggroupGraphql, map[string]*bintree{}},
+		"deleteEnvVariable.graphql":                &bintree{_lgraphqlDeleteenvvariableGraphql, map[string]*bintree{}},
+		"deleteEnvironment.graphql":                &bintree{_lgraphqlDeleteenvironmentGraphql, map[string]*bintree{}},
+		"deleteGroup.graphql":                      &bintree{_lgraphqlDeletegroupGraphql, map[string]*bintree{}},
+		"deleteNotificationEmail.graphql":          &bintree{_lgraphqlDeletenotificationemailGraphql, map[string]*bintree{}},
+		"deleteNotificationMicrosoftTeams.graphql": &bintree{_lgraphqlDeletenotificationmicrosoftteamsGraphql, map[string]*bintree{}},
+		"deleteNotificationRocketChat.graphql":     &bintree{_lgraphqlDeletenotificationrocketchatGraphql, map[string]*bintree{}},
+		"deleteNotificationSlack.graphql":          &bintree{_lgraphqlDeletenotificationslackGraphql, map[string]*bintree{}},
+		"deleteProject.graphql":                    &bintree{_lgraphqlDeleteprojectGraphql, map[string]*bintree{}},
+		"deleteSshKey.graphql":                     &bintree{_lgraphqlDeletesshkeyGraphql, map[string]*bintree{}},
+		"deleteUser.graphql":                       &bintree{_lgraphqlDeleteuserGraphql, map[string]*bintree{}},
+		"deployEnvironmentBranch.graphql":          &bintree{_lgraphqlDeployenvironmentbranchGraphql, map[string]*bintree{}},
+		"deployEnvironmentPromote.graphql":         &bintree{_lgraphqlDeployenvironmentpromoteGraphql, map[string]*bintree{}},
+		"deployEnvironmentPullrequest.graphql":     &bintree{_lgraphqlDeployenvironmentpullrequestGraphql, map[string]*bintree{}},
+		"deploymentByRemoteId.graphql":             &bintree{_lgraphqlDeploymentbyremoteidGraphql, map[string]*bintree{}},
+		"deploymentsByEnvironment.graphql":         &bintree{_lgraphqlDeploymentsbyenvironmentGraphql, map[string]*bintree{}},
+		"environmentByName.graphql":                &bintree{_lgraphqlEnvironmentbynameGraphql, map[string]*bintree{}},
+		"environmentsByProjectName.graphql":        &bintree{_lgraphqlEnvironmentsbyprojectnameGraphql, map[string]*bintree{}},
+		"groupByName.graphql":                      &bintree{_lgraphqlGroupbynameGraphql, map[string]*bintree{}},
+		"me.graphql":                               &bintree{_lgraphqlMeGraphql, map[string]*bintree{}},
+		"notificationsRocketChatByProject.graphql": &bintree{_lgraphqlNotificationsrocketchatbyprojectGraphql, map[string]*bintree{}},
+		"notificationsSlackByProject.graphql":      &bintree{_lgraphqlNotificationsslackbyprojectGraphql, map[string]*bintree{}},
+		"projectByName.graphql":                    &bintree{_lgraphqlProjectbynameGraphql, map[string]*bintree{}},
+		"projectInfoByName.graphql":                &bintree{_lgraphqlProjectinfobynameGraphql, map[string]*bintree{}},
+		"projectsByGroup.graphql":                  &bintree{_lgraphqlProjectsbygroupGraphql, map[string]*bintree{}},
+		"removeGroupsFromProject.graphql":          &bintree{_lgraphqlRemovegroupsfromprojectGraphql, map[string]*bintree{}},
+		"removeNotificationFromProject.graphql":    &bintree{_lgraphqlRemovenotificationfromprojectGraphql, map[string]*bintree{}},
+		"removeProjectFromBillingGroup.graphql":    &bintree{_lgraphqlRemoveprojectfrombillinggroupGraphql, map[string]*bintree{}},
+		"removeUserFromGroup.graphql":              &bintree{_lgraphqlRemoveuserfromgroupGraphql, map[string]*bintree{}},
+		"taskById.graphql":                         &bintree{_lgraphqlTaskbyidGraphql, map[string]*bintree{}},
+		"taskDrushArchiveDump.graphql":             &bintree{_lgraphqlTaskdrusharchivedumpGraphql, map[string]*bintree{}},
+		"taskDrushCacheClear.graphql":              &bintree{_lgraphqlTaskdrushcacheclearGraphql, map[string]*bintree{}},
+		"taskDrushSqlDump.graphql":                 &bintree{_lgraphqlTaskdrushsqldumpGraphql, map[string]*bintree{}},
+		"tasksByEnvironment.graphql":               &bintree{_lgraphqlTasksbyenvironmentGraphql, map[string]*bintree{}},
+		"updateNotificationEmail.graphql":          &bintree{_lgraphqlUpdatenotificationemailGraphql, map[string]*bintree{}},
+		"updateNotificationMicrosoftTeams.graphql": &bintree{_lgraphqlUpdatenotificationmicrosoftteamsGraphql, map[string]*bintree{}},
+		"updateNotificationRocketChat.graphql":     &bintree{_lgraphqlUpdatenotificationrocketchatGraphql, map[string]*bintree{}},
+		"updateNotificationSlack.graphql":          &bintree{_lgraphqlUpdatenotificationslackGraphql, map[string]*bintree{}},
+		"updateProject.graphql":                    &bintree{_lgraphqlUpdateprojectGraphql, map[string]*bintree{}},
+		"updateUser.graphql":                       &bintree{_lgraphqlUpdateuserGraphql, map[string]*bintree{}},
+		"userByEmail.graphql":                      &bintree{_lgraphqlUserbyemailGraphql, map[string]*bintree{}},
 	}},
 }}
 
@@ -2,24 +2,10 @@ package client
 
 import (
 	"context"
-	"fmt"
-	"regexp"
 
-	"github.com/amazeeio/lagoon-cli/internal/lagoon"
 	"github.com/amazeeio/lagoon-cli/internal/schema"
 )
 
-var duplicate = regexp.MustCompile("^graphql: Duplicate entry ")
-
-// wrapErr wraps a response error with a lagoon.ErrExist type if the response
-// is due to an object already existing
-func wrapErr(err error) error {
-	if err != nil && duplicate.MatchString(err.Error()) {
-		return fmt.Errorf("couldn't create object: %w: %v", lagoon.ErrExist, err)
-	}
-	return err
-}
-
 // AddGroup adds a group.
 func (c *Client) AddGroup(
 	ctx context.Context, in *schema.AddGroupInput, out *schema.Group) error {
@@ -27,7 +13,7 @@ func (c *Client) AddGroup(
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.Group `json:"addGroup"`
 	}{
 		Response: out,
@@ -41,7 +27,7 @@ func (c *Client) AddUser(
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.User `json:"addUser"`
 	}{
 		Response: out,
@@ -55,7 +41,7 @@ func (c *Client) AddSSHKey(
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.SSHKey `json:"addSshKey"`
 	}{
 		Response: out,
@@ -69,7 +55,7 @@ func (c *Client) AddUserToGroup(
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.Group `json:"addUserToGroup"`
 	}{
 		Response: out,
@@ -83,7 +69,7 @@ func (c *Client) AddNotificationSlack(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.NotificationSlack `json:"addNotificationSlack"`
 	}{
 		Response: out,
@@ -98,7 +84,7 @@ func (c *Client) AddNotificationRocketChat(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.NotificationRocketChat `json:"addNotificationRocketChat"`
 	}{
 		Response: out,
@@ -113,7 +99,7 @@ func (c *Client) AddNotificationEmail(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.NotificationEmail `json:"addNotificationEmail"`
 	}{
 		Response: out,
@@ -128,7 +114,7 @@ func (c *Client) AddNotificationMicrosoftTeams(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.NotificationMicrosoftTeams `json:"addNotificationMicrosoftTeams"`
 	}{
 		Response: out,
@@ -142,11 +128,11 @@ func (c *Client) AddProject(
 	if err != nil {
 		return err
 	}
-	return wrapErr(c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.Project `json:"addProject"`
 	}{
 		Response: out,
-	}))
+	})
 }
 
 // AddEnvVariable adds an EnvVariable to an Environment or Project.
@@ -156,7 +142,7 @@ func (c *Client) AddEnvVariable(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.EnvKeyValue `json:"addEnvVariable"`
 	}{
 		Response: out,
@@ -170,11 +156,11 @@ func (c *Client) AddOrUpdateEnvironment(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	return wrapErr(c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.Environment `json:"addOrUpdateEnvironment"`
 	}{
 		Response: out,
-	}))
+	})
 }
 
 // AddGroupsToProject adds Groups to a Project.
@@ -184,7 +170,7 @@ func (c *Client) AddGroupsToProject(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.Project `json:"addGroupsToProject"`
 	}{
 		Response: out,
@@ -198,7 +184,7 @@ func (c *Client) AddNotificationToProject(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.Project `json:"addNotificationToProject"`
 	}{
 		Response: out,
@@ -212,7 +198,7 @@ func (c *Client) AddBillingGroup(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.BillingGroup `json:"addBillingGroup"`
 	}{
 		Response: out,
@@ -226,9 +212,445 @@ func (c *Client) AddProjectToBillingGroup(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.Project `json:"addProjectToBillingGroup"`
 	}{
 		Response: out,
 	})
 }
+
+// UpdateProject updates a Project.
+func (c *Client) UpdateProject(ctx context.Context,
+	in *schema.UpdateProjectInput, out *schema.Project) error {
+	req, err := c.newRequest("_lgraphql/updateProject.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.Project `json:"updateProject"`
+	}{
+		Response: out,
+	})
+}
+
+// UpdateUser updates a user.
+func (c *Client) UpdateUser(
+	ctx context.Context, in *schema.UpdateUserInput, out *schema.User) error {
+	req, err := c.newRequest("_lgraphql/updateUser.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.User `json:"updateUser"`
+	}{
+		Response: out,
+	})
+}
+
+// UpdateNotificationSlack updates a Slack notification.
+func (c *Client) UpdateNotificationSlack(ctx context.Context,
+	in *schema.UpdateNotificationSlackInput,
+	out *schema.NotificationSlack) error {
+	req, err := c.newRequest("_lgraphql/updateNotificationSlack.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.NotificationSlack `json:"updateNotificationSlack"`
+	}{
+		Response: out,
+	})
+}
+
+// UpdateNotificationRocketChat updates a RocketChat notification.
+func (c *Client) UpdateNotificationRocketChat(ctx context.Context,
+	in *schema.UpdateNotificationRocketChatInput,
+	out *schema.NotificationRocketChat) error {
+	req, err := c.newRequest("_lgraphql/updateNotificationRocketChat.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.NotificationRocketChat `json:"updateNotificationRocketChat"`
+	}{
+		Response: out,
+	})
+}
+
+// UpdateNotificationEmail updates an Email notification.
+func (c *Client) UpdateNotificationEmail(ctx context.Context,
+	in *schema.UpdateNotificationEmailInput,
+	out *schema.NotificationEmail) error {
+	req, err := c.newRequest("_lgraphql/updateNotificationEmail.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.NotificationEmail `json:"updateNotificationEmail"`
+	}{
+		Response: out,
+	})
+}
+
+// UpdateNotificationMicrosoftTeams updates a MicrosoftTeams notification.
+func (c *Client) UpdateNotificationMicrosoftTeams(ctx context.Context,
+	in *schema.UpdateNotificationMicrosoftTeamsInput,
+	out *schema.NotificationMicrosoftTeams) error {
+	req, err := c.newRequest(
+		"_lgraphql/updateNotificationMicrosoftTeams.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.NotificationMicrosoftTeams `json:"updateNotificationMicrosoftTeams"`
+	}{
+		Response: out,
+	})
+}
+
+// DeleteEnvironment deletes a Project Environment.
+func (c *Client) DeleteEnvironment(ctx context.Context,
+	in *schema.DeleteEnvironmentInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deleteEnvironment.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deleteEnvironment"`
+	}{
+		Response: out,
+	})
+}
+
+// DeleteEnvVariable deletes an EnvVariable from an Environment or Project.
+func (c *Client) DeleteEnvVariable(ctx context.Context,
+	in *schema.DeleteEnvVariableInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deleteEnvVariable.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deleteEnvVariable"`
+	}{
+		Response: out,
+	})
+}
+
+// DeleteSSHKey deletes an SSH key from a user.
+func (c *Client) DeleteSSHKey(ctx context.Context,
+	in *schema.DeleteSSHKeyInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deleteSshKey.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deleteSshKey"`
+	}{
+		Response: out,
+	})
+}
+
+// RemoveGroupsFromProject removes Groups from a Project.
+func (c *Client) RemoveGroupsFromProject(ctx context.Context,
+	in *schema.ProjectGroupsInput, out *schema.Project) error {
+	req, err := c.newRequest("_lgraphql/removeGroupsFromProject.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.Project `json:"removeGroupsFromProject"`
+	}{
+		Response: out,
+	})
+}
+
+// RemoveNotificationFromProject removes a Notification from a Project.
+func (c *Client) RemoveNotificationFromProject(ctx context.Context,
+	in *schema.RemoveNotificationFromProjectInput, out *schema.Project) error {
+	req, err := c.newRequest(
+		"_lgraphql/removeNotificationFromProject.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.Project `json:"removeNotificationFromProject"`
+	}{
+		Response: out,
+	})
+}
+
+// RemoveUserFromGroup removes a user from a group.
+func (c *Client) RemoveUserFromGroup(ctx context.Context,
+	in *schema.UserGroupInput, out *schema.Group) error {
+	req, err := c.newRequest("_lgraphql/removeUserFromGroup.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.Group `json:"removeUserFromGroup"`
+	}{
+		Response: out,
+	})
+}
+
+// DeleteBillingGroup deletes a billing group.
+func (c *Client) DeleteBillingGroup(ctx context.Context,
+	in *schema.DeleteGroupInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deleteBillingGroup.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deleteBillingGroup"`
+	}{
+		Response: out,
+	})
+}
+
+// DeleteGroup deletes a group.
+func (c *Client) DeleteGroup(ctx context.Context,
+	in *schema.DeleteGroupInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deleteGroup.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deleteGroup"`
+	}{
+		Response: out,
+	})
+}
+
+// DeleteUser deletes a user.
+func (c *Client) DeleteUser(ctx context.Context,
+	in *schema.DeleteUserInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deleteUser.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deleteUser"`
+	}{
+		Response: out,
+	})
+}
+
+// DeleteProject deletes a project.
+func (c *Client) DeleteProject(ctx context.Context,
+	in *schema.DeleteProjectInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deleteProject.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deleteProject"`
+	}{
+		Response: out,
+	})
+}
+
+// DeleteNotificationSlack deletes a Slack notification.
+func (c *Client) DeleteNotificationSlack(ctx context.Context,
+	in *schema.DeleteNotificationInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deleteNotificationSlack.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deleteNotificationSlack"`
+	}{
+		Response: out,
+	})
+}
+
+// DeleteNotificationRocketChat deletes a RocketChat notification.
+func (c *Client) DeleteNotificationRocketChat(ctx context.Context,
+	in *schema.DeleteNotificationInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deleteNotificationRocketChat.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deleteNotificationRocketChat"`
+	}{
+		Response: out,
+	})
+}
+
+// DeleteNotificationEmail deletes a Email notification.
+func (c *Client) DeleteNotificationEmail(ctx context.Context,
+	in *schema.DeleteNotificationInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deleteNotificationEmail.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deleteNotificationEmail"`
+	}{
+		Response: out,
+	})
+}
+
+// DeleteNotificationMicrosoftTeams deletes a MicrosoftTeams notification.
+func (c *Client) DeleteNotificationMicrosoftTeams(ctx context.Context,
+	in *schema.DeleteNotificationInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deleteNotificationMicrosoftTeams.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deleteNotificationMicrosoftTeams"`
+	}{
+		Response: out,
+	})
+}
+
+// RemoveProjectFromBillingGroup removes a project from a billing group.
+func (c *Client) RemoveProjectFromBillingGroup(ctx context.Context,
+	in *schema.ProjectBillingGroupInput, out *schema.Project) error {
+	req, err := c.newRequest("_lgraphql/removeProjectFromBillingGroup.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.Project `json:"removeProjectFromBillingGroup"`
+	}{
+		Response: out,
+	})
+}
+
+// DeployEnvironmentBranch deploys a branch, at its latest commit or at
+// in.BranchRef if it is set.
+func (c *Client) DeployEnvironmentBranch(ctx context.Context,
+	in *schema.DeployEnvironmentBranchInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deployEnvironmentBranch.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deployEnvironmentBranch"`
+	}{
+		Response: out,
+	})
+}
+
+// DeployEnvironmentPromote promotes one environment to another.
+func (c *Client) DeployEnvironmentPromote(ctx context.Context,
+	in *schema.DeployEnvironmentPromoteInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deployEnvironmentPromote.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deployEnvironmentPromote"`
+	}{
+		Response: out,
+	})
+}
+
+// DeployEnvironmentPullrequest deploys a pull request.
+func (c *Client) DeployEnvironmentPullrequest(ctx context.Context,
+	in *schema.DeployEnvironmentPullrequestInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/deployEnvironmentPullrequest.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"deployEnvironmentPullrequest"`
+	}{
+		Response: out,
+	})
+}
+
+// CancelDeployment cancels a deployment.
+func (c *Client) CancelDeployment(ctx context.Context,
+	in *schema.CancelDeploymentInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/cancelDeployment.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"cancelDeployment"`
+	}{
+		Response: out,
+	})
+}
+
+// CancelTask cancels a task.
+func (c *Client) CancelTask(ctx context.Context,
+	in *schema.CancelTaskInput, out *string) error {
+	req, err := c.newRequest("_lgraphql/cancelTask.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *string `json:"cancelTask"`
+	}{
+		Response: out,
+	})
+}
+
+// TaskDrushArchiveDump runs a drush archive-dump task on an environment.
+func (c *Client) TaskDrushArchiveDump(
+	ctx context.Context, environmentID uint, out *schema.Task) error {
+	req, err := c.newRequest("_lgraphql/taskDrushArchiveDump.graphql",
+		map[string]interface{}{
+			"environment": environmentID,
+		})
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.Task `json:"taskDrushArchiveDump"`
+	}{
+		Response: out,
+	})
+}
+
+// TaskDrushSQLDump runs a drush sql-dump task on an environment.
+func (c *Client) TaskDrushSQLDump(
+	ctx context.Context, environmentID uint, out *schema.Task) error {
+	req, err := c.newRequest("_lgraphql/taskDrushSqlDump.graphql",
+		map[string]interface{}{
+			"environment": environmentID,
+		})
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.Task `json:"taskDrushSqlDump"`
+	}{
+		Response: out,
+	})
+}
+
+// TaskDrushCacheClear runs a drush cache-clear task on an environment.
+func (c *Client) TaskDrushCacheClear(
+	ctx context.Context, environmentID uint, out *schema.Task) error {
+	req, err := c.newRequest("_lgraphql/taskDrushCacheClear.graphql",
+		map[string]interface{}{
+			"environment": environmentID,
+		})
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.Task `json:"taskDrushCacheClear"`
+	}{
+		Response: out,
+	})
+}
+
+// AddTask adds a task to an environment.
+func (c *Client) AddTask(
+	ctx context.Context, in *schema.AddTaskInput, out *schema.Task) error {
+	req, err := c.newRequest("_lgraphql/addTask.graphql", in)
+	if err != nil {
+		return err
+	}
+	return c.run(ctx, req, &struct {
+		Response *schema.Task `json:"addTask"`
+	}{
+		Response: out,
+	})
+}
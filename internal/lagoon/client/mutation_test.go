@@ -180,3 +180,42 @@ func TestAddUser(t *testing.T) {
 		})
 	}
 }
+
+func TestDeployEnvironmentPullrequestRequest(t *testing.T) {
+	expect := "testdata/deployEnvironmentPullrequestRequest0.golden.graphql"
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(_ http.ResponseWriter, r *http.Request) {
+			requestBody, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatalf("couldn't read request body: %v", err)
+			}
+			if *update {
+				t.Logf("update golden file: %s", expect)
+				if err = ioutil.WriteFile(expect, requestBody, 0644); err != nil {
+					t.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+			expected, err := ioutil.ReadFile(expect)
+			if err != nil {
+				t.Fatalf("couldn't read file: %v", err)
+			}
+			if !bytes.Equal(requestBody, expected) {
+				t.Logf("result:\n%s\nexpected:\n%s", requestBody, expected)
+				t.Fatalf("result does not match expected")
+			}
+		}))
+	defer ts.Close()
+	c := client.New(ts.URL, "", "", false)
+	var result string
+	// ignore response error - we're testing the request
+	_ = c.DeployEnvironmentPullrequest(context.Background(),
+		&schema.DeployEnvironmentPullrequestInput{
+			Project:        schema.ProjectInput{Name: "foo"},
+			Number:         42,
+			Title:          "Add bananas",
+			BaseBranchName: "main",
+			BaseBranchRef:  "0123abc",
+			HeadBranchName: "feature/bananas",
+			HeadBranchRef:  "4567def",
+		}, &result)
+}
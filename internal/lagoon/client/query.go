@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/amazeeio/lagoon-cli/internal/schema"
 )
@@ -19,7 +20,7 @@ func (c *Client) ProjectByName(
 		return err
 	}
 
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.Project `json:"projectByName"`
 	}{
 		Response: project,
@@ -37,7 +38,7 @@ func (c *Client) Me(
 		return err
 	}
 
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.User `json:"me"`
 	}{
 		Response: user,
@@ -58,9 +59,389 @@ func (c *Client) EnvironmentByName(ctx context.Context, name string,
 		return err
 	}
 
-	return c.client.Run(ctx, req, &struct {
+	return c.run(ctx, req, &struct {
 		Response *schema.Environment `json:"environmentByName"`
 	}{
 		Response: environment,
 	})
 }
+
+var groupNotFound = regexp.MustCompile("^graphql: Group not found")
+
+// GroupByName queries the Lagoon API for a group by its name, and unmarshals
+// the response into groups. The group may be a regular or billing group, so
+// the caller should check the relevant field of groups. If the group doesn't
+// exist, groups is not modified.
+func (c *Client) GroupByName(
+	ctx context.Context, name string, groups *schema.Groups) error {
+
+	req, err := c.newVersionedRequest("_lgraphql/groupByName.graphql",
+		map[string]interface{}{
+			"name": name,
+		})
+	if err != nil {
+		return err
+	}
+
+	err = c.run(ctx, req, &struct {
+		Response *schema.Groups `json:"allGroups"`
+	}{
+		Response: groups,
+	})
+	if err != nil && groupNotFound.MatchString(err.Error()) {
+		return nil
+	}
+	return err
+}
+
+// UserByEmail queries the Lagoon API for a user by their email address, and
+// unmarshals the response into user. If the user doesn't exist, user is not
+// modified.
+func (c *Client) UserByEmail(
+	ctx context.Context, email string, user *schema.User) error {
+
+	req, err := c.newRequest("_lgraphql/userByEmail.graphql",
+		map[string]interface{}{
+			"email": email,
+		})
+	if err != nil {
+		return err
+	}
+
+	users := []schema.User{}
+	err = c.run(ctx, req, &struct {
+		Response *[]schema.User `json:"allUsers"`
+	}{
+		Response: &users,
+	})
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		if u.Email == email {
+			*user = u
+			break
+		}
+	}
+	return nil
+}
+
+// AllProjects queries the Lagoon API for all projects, and unmarshals the
+// response into projects. Only the name of each project is queried.
+func (c *Client) AllProjects(
+	ctx context.Context, projects *[]schema.Project) error {
+
+	req, err := c.newRequest("_lgraphql/allProjects.graphql",
+		map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *[]schema.Project `json:"allProjects"`
+	}{
+		Response: projects,
+	})
+}
+
+// AllProjectsInfo queries the Lagoon API for all projects, and unmarshals
+// the response into projects. Basic info on each project and the type of its
+// environments is queried.
+func (c *Client) AllProjectsInfo(
+	ctx context.Context, projects *[]schema.Project) error {
+
+	req, err := c.newRequest("_lgraphql/allProjectsInfo.graphql",
+		map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *[]schema.Project `json:"allProjects"`
+	}{
+		Response: projects,
+	})
+}
+
+// ProjectsByGroup queries the Lagoon API for the projects in a group by the
+// group name, and unmarshals the response into projects. Only the name of
+// each project is queried.
+func (c *Client) ProjectsByGroup(
+	ctx context.Context, group string, projects *[]schema.Project) error {
+
+	req, err := c.newRequest("_lgraphql/projectsByGroup.graphql",
+		map[string]interface{}{
+			"name": group,
+		})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *[]schema.Project `json:"allProjectsInGroup"`
+	}{
+		Response: projects,
+	})
+}
+
+// ProjectInfoByName queries the Lagoon API for a project by its name, and
+// unmarshals the response into project. Only the project fields displayed by
+// the CLI, its envVariables, and a summary of its environments are queried.
+func (c *Client) ProjectInfoByName(
+	ctx context.Context, name string, project *schema.Project) error {
+
+	req, err := c.newRequest("_lgraphql/projectInfoByName.graphql",
+		map[string]interface{}{
+			"name": name,
+		})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *schema.Project `json:"projectByName"`
+	}{
+		Response: project,
+	})
+}
+
+// DeploymentsByEnvironment queries the Lagoon API for the deployments of an
+// environment by its name and parent projectID, and unmarshals the response
+// into deployments.
+func (c *Client) DeploymentsByEnvironment(ctx context.Context, name string,
+	projectID uint, deployments *[]schema.Deployment) error {
+
+	req, err := c.newRequest("_lgraphql/deploymentsByEnvironment.graphql",
+		map[string]interface{}{
+			"name":    name,
+			"project": projectID,
+		})
+	if err != nil {
+		return err
+	}
+
+	environment := struct {
+		Deployments *[]schema.Deployment `json:"deployments"`
+	}{
+		Deployments: deployments,
+	}
+	return c.run(ctx, req, &struct {
+		Response interface{} `json:"environmentByName"`
+	}{
+		Response: &environment,
+	})
+}
+
+// DeploymentByRemoteID queries the Lagoon API for a deployment by its remote
+// ID, and unmarshals the response into deployment. The build log of the
+// deployment is included.
+func (c *Client) DeploymentByRemoteID(ctx context.Context, id string,
+	deployment *schema.Deployment) error {
+
+	req, err := c.newRequest("_lgraphql/deploymentByRemoteId.graphql",
+		map[string]interface{}{
+			"id": id,
+		})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *schema.Deployment `json:"deploymentByRemoteId"`
+	}{
+		Response: deployment,
+	})
+}
+
+// TaskByID queries the Lagoon API for a task by its ID, and unmarshals the
+// response into task. The logs and files of the task are included.
+func (c *Client) TaskByID(ctx context.Context, id uint,
+	task *schema.Task) error {
+
+	req, err := c.newRequest("_lgraphql/taskById.graphql",
+		map[string]interface{}{
+			"id": id,
+		})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *schema.Task `json:"taskById"`
+	}{
+		Response: task,
+	})
+}
+
+// TasksByEnvironment queries the Lagoon API for the tasks of an environment
+// by its name and parent projectID, and unmarshals the response into tasks.
+func (c *Client) TasksByEnvironment(ctx context.Context, name string,
+	projectID uint, tasks *[]schema.Task) error {
+
+	req, err := c.newRequest("_lgraphql/tasksByEnvironment.graphql",
+		map[string]interface{}{
+			"name":    name,
+			"project": projectID,
+		})
+	if err != nil {
+		return err
+	}
+
+	environment := struct {
+		Tasks *[]schema.Task `json:"tasks"`
+	}{
+		Tasks: tasks,
+	}
+	return c.run(ctx, req, &struct {
+		Response interface{} `json:"environmentByName"`
+	}{
+		Response: &environment,
+	})
+}
+
+// AllGroups queries the Lagoon API for all groups, or only the groups with
+// the given name if it isn't empty, and unmarshals the response into groups.
+// The members of each group are queried along with their SSH keys, as well as
+// the projects in each group.
+func (c *Client) AllGroups(
+	ctx context.Context, name string, groups *[]schema.Group) error {
+
+	vars := map[string]interface{}{}
+	if name != "" {
+		vars["name"] = name
+	}
+	req, err := c.newRequest("_lgraphql/allGroups.graphql", vars)
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *[]schema.Group `json:"allGroups"`
+	}{
+		Response: groups,
+	})
+}
+
+// AllGroupNames queries the Lagoon API for the names of all groups, and
+// unmarshals the response into groups.
+func (c *Client) AllGroupNames(
+	ctx context.Context, groups *[]schema.Group) error {
+
+	req, err := c.newRequest("_lgraphql/allGroupNames.graphql",
+		map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *[]schema.Group `json:"allGroups"`
+	}{
+		Response: groups,
+	})
+}
+
+// EnvironmentsByProjectName queries the Lagoon API for the environments of a
+// project by the project name, and unmarshals the response into environments.
+// Only the names of the environments and their services are queried.
+func (c *Client) EnvironmentsByProjectName(ctx context.Context, name string,
+	environments *[]schema.EnvironmentServices) error {
+
+	req, err := c.newRequest("_lgraphql/environmentsByProjectName.graphql",
+		map[string]interface{}{
+			"name": name,
+		})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *struct {
+			Environments *[]schema.EnvironmentServices `json:"environments"`
+		} `json:"projectByName"`
+	}{
+		Response: &struct {
+			Environments *[]schema.EnvironmentServices `json:"environments"`
+		}{
+			Environments: environments,
+		},
+	})
+}
+
+// NotificationsSlackByProject queries the Lagoon API for the Slack
+// notifications of a project by the project name, and unmarshals the response
+// into project.
+func (c *Client) NotificationsSlackByProject(
+	ctx context.Context, name string, project *schema.ProjectSlacks) error {
+
+	req, err := c.newRequest("_lgraphql/notificationsSlackByProject.graphql",
+		map[string]interface{}{
+			"name": name,
+		})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *schema.ProjectSlacks `json:"projectByName"`
+	}{
+		Response: project,
+	})
+}
+
+// AllNotificationsSlack queries the Lagoon API for the Slack notifications of
+// all projects, and unmarshals the response into projects.
+func (c *Client) AllNotificationsSlack(
+	ctx context.Context, projects *[]schema.ProjectSlacks) error {
+
+	req, err := c.newRequest("_lgraphql/allNotificationsSlack.graphql",
+		map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *[]schema.ProjectSlacks `json:"allProjects"`
+	}{
+		Response: projects,
+	})
+}
+
+// NotificationsRocketChatByProject queries the Lagoon API for the RocketChat
+// notifications of a project by the project name, and unmarshals the response
+// into project.
+func (c *Client) NotificationsRocketChatByProject(ctx context.Context,
+	name string, project *schema.ProjectRocketChats) error {
+
+	req, err := c.newRequest(
+		"_lgraphql/notificationsRocketChatByProject.graphql",
+		map[string]interface{}{
+			"name": name,
+		})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *schema.ProjectRocketChats `json:"projectByName"`
+	}{
+		Response: project,
+	})
+}
+
+// AllNotificationsRocketChat queries the Lagoon API for the RocketChat
+// notifications of all projects, and unmarshals the response into projects.
+func (c *Client) AllNotificationsRocketChat(
+	ctx context.Context, projects *[]schema.ProjectRocketChats) error {
+
+	req, err := c.newRequest("_lgraphql/allNotificationsRocketChat.graphql",
+		map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+
+	return c.run(ctx, req, &struct {
+		Response *[]schema.ProjectRocketChats `json:"allProjects"`
+	}{
+		Response: projects,
+	})
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/machinebox/graphql"
+)
+
+// RetryPolicy configures how requests which fail with a transient error are
+// retried.
+//
+// Queries are retried after any network error or a 429 or 5xx response.
+// Mutations are only retried if the request can't have reached the Lagoon API:
+// after a failure to connect, or a 502, 503 or 504 response from the gateway.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried. Zero
+	// disables retries.
+	MaxRetries int
+	// MinBackoff is the maximum delay before the first retry. The maximum
+	// delay doubles with each retry up to MaxBackoff, and the actual delay is
+	// chosen at random up to the maximum.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the RetryPolicy of a Client returned by New.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: 500 * time.Millisecond,
+	MaxBackoff: 10 * time.Second,
+}
+
+// SetRetryPolicy sets the RetryPolicy of the Client.
+func (c *Client) SetRetryPolicy(p RetryPolicy) {
+	c.retry = p
+}
+
+// backoff returns the delay before the given retry, counting from zero. This
+// is the "full jitter" strategy: the delay is random, between zero and an
+// exponentially increasing maximum.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	max := p.MinBackoff
+	for i := 0; i < retry && max < p.MaxBackoff; i++ {
+		max *= 2
+	}
+	if max > p.MaxBackoff {
+		max = p.MaxBackoff
+	}
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+// run sends the request, retrying transient failures according to the
+// RetryPolicy of the Client, and classifies the returned error, including the
+// last error of a request which was retried.
+func (c *Client) run(ctx context.Context, req *graphql.Request,
+	resp interface{}) error {
+	mutation := isMutation(req.Query())
+	for retry := 0; ; retry++ {
+		err := c.client.Run(ctx, req, resp)
+		if err == nil {
+			return nil
+		}
+		if !isTransient(ctx, err, mutation) {
+			return classifyErr(err)
+		}
+		if retry >= c.retry.MaxRetries {
+			if retry > 0 {
+				return fmt.Errorf("gave up after %d attempts: %w", retry+1,
+					classifyErr(err))
+			}
+			return classifyErr(err)
+		}
+		timer := time.NewTimer(c.retry.backoff(retry))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return classifyErr(err)
+		case <-timer.C:
+		}
+	}
+}
+
+// isMutation returns true if the query is a mutation.
+func isMutation(query string) bool {
+	match := operation.FindStringSubmatch(query)
+	return match != nil && match[1] == "mutation"
+}
+
+// isTransient returns true if err is a transient failure of a request which
+// can be retried.
+func isTransient(ctx context.Context, err error, mutation bool) bool {
+	if ctx.Err() != nil {
+		return false // cancelled, or out of time
+	}
+	if match := statusCode.FindStringSubmatch(err.Error()); match != nil {
+		code, _ := strconv.Atoi(match[1])
+		switch code {
+		case 502, 503, 504:
+			return true
+		}
+		return !mutation && (code == 429 || code >= 500)
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	if mutation || isCertificateError(err) {
+		return false
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) ||
+		strings.HasPrefix(err.Error(), "reading body: ")
+}
+
+// isCertificateError returns true if err is due to a server certificate which
+// couldn't be verified. These errors aren't transient.
+func isCertificateError(err error) bool {
+	var unknownAuthority x509.UnknownAuthorityError
+	var invalid x509.CertificateInvalidError
+	var hostname x509.HostnameError
+	return errors.As(err, &unknownAuthority) || errors.As(err, &invalid) ||
+		errors.As(err, &hostname)
+}
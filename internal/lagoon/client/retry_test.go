@@ -0,0 +1,144 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/lagoon/client"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+// response is a canned HTTP response of the test server.
+type response struct {
+	status int
+	body   string
+}
+
+func TestRetry(t *testing.T) {
+	ok := response{status: http.StatusOK,
+		body: `{"data":{"me":{"email":"a@example.com"},` +
+			`"addUser":{"email":"a@example.com"}}}`}
+	badGateway := response{status: http.StatusBadGateway, body: "Bad Gateway"}
+	serverError := response{status: http.StatusInternalServerError,
+		body: "Internal Server Error"}
+	var testCases = map[string]struct {
+		mutation       bool
+		responses      []response
+		expectRequests int
+		expectErr      string
+	}{
+		"query succeeds after retries": {
+			responses:      []response{badGateway, serverError, ok},
+			expectRequests: 3,
+		},
+		"query gives up": {
+			responses: []response{serverError, serverError, serverError,
+				serverError, ok},
+			expectRequests: 4,
+			expectErr: "gave up after 4 attempts: graphql: server returned a " +
+				"non-200 status code: 500",
+		},
+		"mutation retried on gateway error": {
+			mutation:       true,
+			responses:      []response{badGateway, ok},
+			expectRequests: 2,
+		},
+		"mutation not retried on server error": {
+			mutation:       true,
+			responses:      []response{serverError, ok},
+			expectRequests: 1,
+			expectErr:      "graphql: server returned a non-200 status code: 500",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			var mu sync.Mutex
+			var requests int
+			ts := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, _ *http.Request) {
+					mu.Lock()
+					r := tc.responses[requests]
+					requests++
+					mu.Unlock()
+					w.WriteHeader(r.status)
+					fmt.Fprint(w, r.body)
+				}))
+			defer ts.Close()
+			c := client.New(ts.URL, "", "", false)
+			c.SetRetryPolicy(client.RetryPolicy{
+				MaxRetries: 3,
+				MinBackoff: time.Millisecond,
+				MaxBackoff: 5 * time.Millisecond,
+			})
+
+			var err error
+			if tc.mutation {
+				err = c.AddUser(context.Background(),
+					&schema.AddUserInput{Email: "a@example.com"}, &schema.User{})
+			} else {
+				err = c.Me(context.Background(), &schema.User{})
+			}
+			if tc.expectErr == "" && err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if tc.expectErr != "" && (err == nil || err.Error() != tc.expectErr) {
+				tt.Fatalf("expected error %q, got %v", tc.expectErr, err)
+			}
+			if requests != tc.expectRequests {
+				tt.Fatalf("expected %d requests, got %d", tc.expectRequests, requests)
+			}
+		})
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	var testCases = map[string]struct {
+		response response
+		expect   error
+	}{
+		"duplicate": {
+			response: response{status: http.StatusOK,
+				body: `{"errors":[{"message":"Duplicate entry 'a' for key 'name'"}]}`},
+			expect: lagoon.ErrExist,
+		},
+		"not found": {
+			response: response{status: http.StatusOK,
+				body: `{"errors":[{"message":"Group not found"}]}`},
+			expect: lagoon.ErrNotFound,
+		},
+		"forbidden": {
+			response: response{status: http.StatusOK,
+				body: `{"errors":[{"message":"Unauthorized: You don't have ` +
+					`permission to \"view\" on \"project\": {\"project\":1}"}]}`},
+			expect: lagoon.ErrForbidden,
+		},
+		"bad token": {
+			response: response{status: http.StatusUnauthorized,
+				body: "Unauthorized - Bearer Token Not Recognized"},
+			expect: lagoon.ErrUnauthorized,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(
+				func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(tc.response.status)
+					fmt.Fprint(w, tc.response.body)
+				}))
+			defer ts.Close()
+			c := client.New(ts.URL, "", "", false)
+
+			err := c.Me(context.Background(), &schema.User{})
+			if !errors.Is(err, tc.expect) {
+				tt.Fatalf("expected %v error, got %v", tc.expect, err)
+			}
+		})
+	}
+}
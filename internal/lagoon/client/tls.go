@@ -0,0 +1,72 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// TLSConfig configures the TLS connections of a Client to the Lagoon API.
+type TLSConfig struct {
+	// CAFile is the path to a PEM file of CA certificates which are trusted in
+	// addition to the system certificate pool.
+	CAFile string
+	// ClientCert and ClientKey are the paths to a PEM certificate and key used
+	// to authenticate the client. Either both or neither must be set.
+	ClientCert string
+	ClientKey  string
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
+}
+
+// Validate checks that the files referred to by t can be loaded.
+func (t TLSConfig) Validate() error {
+	_, err := t.tlsClientConfig()
+	return err
+}
+
+// tlsClientConfig returns the tls.Config described by t.
+func (t TLSConfig) tlsClientConfig() (*tls.Config, error) {
+	config := tls.Config{
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+	if t.CAFile != "" {
+		pem, err := ioutil.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read CA file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file %s", t.CAFile)
+		}
+		config.RootCAs = pool
+	}
+	if (t.ClientCert == "") != (t.ClientKey == "") {
+		return nil, fmt.Errorf("client-cert and client-key must be set together")
+	}
+	if t.ClientCert != "" {
+		cert, err := tls.LoadX509KeyPair(t.ClientCert, t.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return &config, nil
+}
+
+// HTTPClient returns an http.Client with a dedicated transport configured by
+// t, so that the TLS settings of one Client don't affect any other.
+func (t TLSConfig) HTTPClient() (*http.Client, error) {
+	tlsConfig, err := t.tlsClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}, nil
+}
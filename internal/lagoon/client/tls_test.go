@@ -0,0 +1,98 @@
+package client_test
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon/client"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+func TestTLSConfig(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, `{"data":{"me":{"email":"a@example.com"}}}`)
+		}))
+	defer ts.Close()
+	dir, err := ioutil.TempDir("", "lagoon-cli-tls")
+	if err != nil {
+		t.Fatalf("couldn't create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	caFile := filepath.Join(dir, "ca.pem")
+	err = ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: ts.Certificate().Raw,
+	}), 0600)
+	if err != nil {
+		t.Fatalf("couldn't write CA file: %v", err)
+	}
+
+	var testCases = map[string]struct {
+		tlsConfig client.TLSConfig
+		expectErr string
+	}{
+		"unknown CA": {
+			expectErr: "certificate signed by unknown authority",
+		},
+		"CA file": {
+			tlsConfig: client.TLSConfig{CAFile: caFile},
+		},
+		"insecure": {
+			tlsConfig: client.TLSConfig{InsecureSkipVerify: true},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			c, err := client.NewWithTLS(ts.URL, "", "", false, tc.tlsConfig)
+			if err != nil {
+				tt.Fatalf("couldn't create client: %v", err)
+			}
+			err = c.Me(context.Background(), &schema.User{})
+			if tc.expectErr == "" && err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if tc.expectErr != "" &&
+				(err == nil || !strings.Contains(err.Error(), tc.expectErr)) {
+				tt.Fatalf("expected error containing %q, got %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestTLSConfigValidate(t *testing.T) {
+	var testCases = map[string]struct {
+		tlsConfig client.TLSConfig
+		expectErr string
+	}{
+		"default": {},
+		"missing CA file": {
+			tlsConfig: client.TLSConfig{CAFile: "testdata/missing.pem"},
+			expectErr: "couldn't read CA file",
+		},
+		"cert without key": {
+			tlsConfig: client.TLSConfig{ClientCert: "testdata/client.pem"},
+			expectErr: "client-cert and client-key must be set together",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			err := tc.tlsConfig.Validate()
+			if tc.expectErr == "" && err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if tc.expectErr != "" &&
+				(err == nil || !strings.HasPrefix(err.Error(), tc.expectErr)) {
+				tt.Fatalf("expected error %q, got %v", tc.expectErr, err)
+			}
+		})
+	}
+}
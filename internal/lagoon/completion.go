@@ -0,0 +1,101 @@
+package lagoon
+
+import (
+	"context"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+// Completer interface contains methods for listing the names of objects for
+// shell completion. Project names are listed with ProjectNames.
+type Completer interface {
+	ProjectLister
+	EnvironmentsByProjectName(ctx context.Context, name string,
+		environments *[]schema.EnvironmentServices) error
+	AllGroupNames(ctx context.Context, groups *[]schema.Group) error
+	AllNotificationsSlack(ctx context.Context,
+		projects *[]schema.ProjectSlacks) error
+	AllNotificationsRocketChat(ctx context.Context,
+		projects *[]schema.ProjectRocketChats) error
+}
+
+// EnvironmentNames returns the names of the environments of a project.
+func EnvironmentNames(ctx context.Context, c Completer,
+	project string) ([]string, error) {
+	var environments []schema.EnvironmentServices
+	if err := c.EnvironmentsByProjectName(ctx, project, &environments); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range environments {
+		names = append(names, e.Name)
+	}
+	return uniqueSorted(names), nil
+}
+
+// ServiceNames returns the names of the services of an environment. If
+// environment is empty, the services of all environments of the project are
+// returned.
+func ServiceNames(ctx context.Context, c Completer,
+	project, environment string) ([]string, error) {
+	var environments []schema.EnvironmentServices
+	if err := c.EnvironmentsByProjectName(ctx, project, &environments); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range environments {
+		if environment != "" && e.Name != environment {
+			continue
+		}
+		for _, s := range e.Services {
+			names = append(names, s.Name)
+		}
+	}
+	return uniqueSorted(names), nil
+}
+
+// GroupNames returns the names of all groups.
+func GroupNames(ctx context.Context, c Completer) ([]string, error) {
+	var groups []schema.Group
+	if err := c.AllGroupNames(ctx, &groups); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, g := range groups {
+		names = append(names, g.Name)
+	}
+	return uniqueSorted(names), nil
+}
+
+// SlackNotificationNames returns the names of the Slack notifications of all
+// projects.
+func SlackNotificationNames(ctx context.Context, c Completer) ([]string, error) {
+	var projects []schema.ProjectSlacks
+	if err := c.AllNotificationsSlack(ctx, &projects); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, p := range projects {
+		for _, n := range p.Slacks {
+			names = append(names, n.Name)
+		}
+	}
+	return uniqueSorted(names), nil
+}
+
+// RocketChatNotificationNames returns the names of the RocketChat
+// notifications of all projects.
+func RocketChatNotificationNames(ctx context.Context,
+	c Completer) ([]string, error) {
+	var projects []schema.ProjectRocketChats
+	if err := c.AllNotificationsRocketChat(ctx, &projects); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, p := range projects {
+		for _, n := range p.RocketChats {
+			names = append(names, n.Name)
+		}
+	}
+	return uniqueSorted(names), nil
+}
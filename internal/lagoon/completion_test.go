@@ -0,0 +1,87 @@
+//go:generate mockgen -source=completion.go -destination=../mock/mock_completer.go -package=mock -aux_files=github.com/amazeeio/lagoon-cli/internal/lagoon=export.go
+package lagoon_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/mock"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/golang/mock/gomock"
+)
+
+func TestServiceNames(t *testing.T) {
+	var testCases = map[string]struct {
+		environment string
+		expect      []string
+	}{
+		"environment": {
+			environment: "master",
+			expect:      []string{"cli", "nginx", "php"},
+		},
+		"all environments": {
+			expect: []string{"cli", "nginx", "php", "solr"},
+		},
+		"unknown environment": {
+			environment: "develop",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+			c := mock.NewMockCompleter(ctrl)
+			c.EXPECT().EnvironmentsByProjectName(ctx, "bananas", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string,
+					out *[]schema.EnvironmentServices) error {
+					*out = []schema.EnvironmentServices{
+						{Name: "master", Services: []schema.EnvironmentService{
+							{Name: "nginx"}, {Name: "php"}, {Name: "cli"}}},
+						{Name: "pr-1", Services: []schema.EnvironmentService{
+							{Name: "cli"}, {Name: "solr"}}},
+					}
+					return nil
+				})
+			names, err := lagoon.ServiceNames(ctx, c, "bananas", tc.environment)
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(names, tc.expect) {
+				tt.Fatalf("expected %v, got %v", tc.expect, names)
+			}
+		})
+	}
+}
+
+func TestSlackNotificationNames(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	c := mock.NewMockCompleter(ctrl)
+	c.EXPECT().AllNotificationsSlack(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, out *[]schema.ProjectSlacks) error {
+			slack := func(name string) schema.NotificationSlack {
+				n := schema.NotificationSlack{}
+				n.Name = name
+				return n
+			}
+			*out = []schema.ProjectSlacks{
+				{Name: "bananas", Slacks: []schema.NotificationSlack{
+					slack("deploys"), slack("alerts")}},
+				{Name: "apples", Slacks: []schema.NotificationSlack{
+					slack("deploys")}},
+				{Name: "pears"},
+			}
+			return nil
+		})
+	names, err := lagoon.SlackNotificationNames(ctx, c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expect := []string{"alerts", "deploys"}; !reflect.DeepEqual(names, expect) {
+		t.Fatalf("expected %v, got %v", expect, names)
+	}
+}
@@ -0,0 +1,194 @@
+package lagoon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+)
+
+// Deployments interface contains methods for getting info on the deployments
+// of environments.
+type Deployments interface {
+	ProjectInfo
+	DeploymentsByEnvironment(ctx context.Context, name string, projectID uint,
+		deployments *[]schema.Deployment) error
+}
+
+// GetDeployments gets the deployments of an environment by project and
+// environment name.
+func GetDeployments(ctx context.Context, d Deployments,
+	projectName, environmentName string) ([]schema.Deployment, error) {
+	project, err := GetProjectInfo(ctx, d, projectName)
+	if err != nil {
+		return nil, err
+	}
+	deployments := []schema.Deployment{}
+	err = d.DeploymentsByEnvironment(
+		ctx, environmentName, project.ID, &deployments)
+	return deployments, err
+}
+
+// DeploymentLogs interface contains methods for getting the build logs of
+// deployments.
+type DeploymentLogs interface {
+	Deployments
+	DeploymentByRemoteID(ctx context.Context, id string,
+		deployment *schema.Deployment) error
+}
+
+// DeploymentFinished returns true if a deployment with the given status has
+// finished and its build log won't change anymore. The Lagoon API returns
+// statuses in lower case.
+func DeploymentFinished(status api.DeploymentStatusType) bool {
+	for _, finished := range []api.DeploymentStatusType{api.CompleteDeploy,
+		api.FailedDeploy, api.ErrorDeploy, api.CancelledDeploy} {
+		if strings.EqualFold(string(status), string(finished)) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDeploymentByName gets a deployment of an environment, including its
+// build log, by project, environment and deployment name.
+func GetDeploymentByName(ctx context.Context, d DeploymentLogs,
+	projectName, environmentName, name string) (*schema.Deployment, error) {
+	deployments, err := GetDeployments(ctx, d, projectName, environmentName)
+	if err != nil {
+		return nil, err
+	}
+	for _, deployment := range deployments {
+		if deployment.Name == name {
+			return GetDeploymentByRemoteID(ctx, d, deployment.RemoteID)
+		}
+	}
+	return nil, fmt.Errorf("deployment %s of environment %s: %w", name,
+		environmentName, ErrNotFound)
+}
+
+// GetDeploymentByRemoteID gets a deployment, including its build log, by its
+// remote ID.
+func GetDeploymentByRemoteID(ctx context.Context, d DeploymentLogs,
+	remoteID string) (*schema.Deployment, error) {
+	deployment := schema.Deployment{}
+	if err := d.DeploymentByRemoteID(ctx, remoteID, &deployment); err != nil {
+		return nil, err
+	}
+	if deployment.ID == 0 {
+		return nil, fmt.Errorf("deployment %s: %w", remoteID, ErrNotFound)
+	}
+	return &deployment, nil
+}
+
+// FollowDeploymentLog writes the build log of a deployment to w, and polls it
+// every interval to write the lines added since, until the deployment has
+// finished. The deployment is returned in its finished state.
+func FollowDeploymentLog(ctx context.Context, d DeploymentLogs,
+	deployment *schema.Deployment, interval time.Duration,
+	w io.Writer) (*schema.Deployment, error) {
+	written := ""
+	for {
+		log := deployment.BuildLog
+		if !strings.HasPrefix(log, written) {
+			// the log was replaced, e.g. because the build was restarted
+			written = ""
+		}
+		if _, err := io.WriteString(w, log[len(written):]); err != nil {
+			return deployment, err
+		}
+		written = log
+		if DeploymentFinished(deployment.Status) {
+			if written != "" && !strings.HasSuffix(written, "\n") {
+				_, err := io.WriteString(w, "\n")
+				return deployment, err
+			}
+			return deployment, nil
+		}
+		select {
+		case <-ctx.Done():
+			return deployment, ctx.Err()
+		case <-time.After(interval):
+		}
+		var err error
+		deployment, err = GetDeploymentByRemoteID(ctx, d, deployment.RemoteID)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// DeploymentNames returns the names of the current deployments of an
+// environment, so that a deployment started afterwards can be told apart by
+// WaitForDeployment. An environment which doesn't exist yet has no
+// deployments.
+func DeploymentNames(ctx context.Context, d Deployments,
+	projectName, environmentName string) (map[string]bool, error) {
+	deployments, err := GetDeployments(ctx, d, projectName, environmentName)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for _, deployment := range deployments {
+		names[deployment.Name] = true
+	}
+	return names, nil
+}
+
+// WaitForDeployment polls the deployments of an environment every interval
+// until a deployment which isn't in known has finished, and returns it.
+// progress is called with the new deployment whenever its status changes.
+func WaitForDeployment(ctx context.Context, d Deployments,
+	projectName, environmentName string, known map[string]bool,
+	interval time.Duration,
+	progress func(*schema.Deployment)) (*schema.Deployment, error) {
+	project, err := GetProjectInfo(ctx, d, projectName)
+	if err != nil {
+		return nil, err
+	}
+	var status api.DeploymentStatusType
+	for {
+		var deployments []schema.Deployment
+		err = d.DeploymentsByEnvironment(ctx, environmentName, project.ID,
+			&deployments)
+		// the environment of a new branch is created by its first deployment
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+		if deployment := newestDeployment(deployments, known); deployment != nil {
+			if deployment.Status != status {
+				status = deployment.Status
+				progress(deployment)
+			}
+			if DeploymentFinished(deployment.Status) {
+				return deployment, nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// newestDeployment returns the newest of the deployments which aren't in
+// known, or nil if there are none.
+func newestDeployment(deployments []schema.Deployment,
+	known map[string]bool) *schema.Deployment {
+	var newest *schema.Deployment
+	for i, deployment := range deployments {
+		if known[deployment.Name] {
+			continue
+		}
+		if newest == nil || deployment.ID > newest.ID {
+			newest = &deployments[i]
+		}
+	}
+	return newest
+}
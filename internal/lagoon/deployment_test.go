@@ -0,0 +1,150 @@
+//go:generate mockgen -source=deployment.go -destination=../mock/mock_deployments.go -package=mock -aux_files=github.com/amazeeio/lagoon-cli/internal/lagoon=environment.go
+package lagoon_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/mock"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+	"github.com/golang/mock/gomock"
+)
+
+func TestGetDeployments(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockDeployments(ctrl)
+	d.EXPECT().ProjectInfoByName(ctx, "bananas", gomock.Any()).
+		DoAndReturn(setProject(bananas(7)))
+	d.EXPECT().DeploymentsByEnvironment(ctx, "master", uint(7), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ uint,
+			out *[]schema.Deployment) error {
+			*out = []schema.Deployment{{ID: 1, Name: "build-1"}}
+			return nil
+		})
+	deployments, err := lagoon.GetDeployments(ctx, d, "bananas", "master")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deployments) != 1 || deployments[0].Name != "build-1" {
+		t.Fatalf("unexpected deployments: %v", deployments)
+	}
+}
+
+func TestGetDeploymentByName(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockDeploymentLogs(ctrl)
+	d.EXPECT().ProjectInfoByName(ctx, "bananas", gomock.Any()).
+		DoAndReturn(setProject(bananas(7))).Times(2)
+	d.EXPECT().DeploymentsByEnvironment(ctx, "master", uint(7), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ uint,
+			out *[]schema.Deployment) error {
+			*out = []schema.Deployment{
+				{ID: 1, Name: "build-1", RemoteID: "abc"},
+				{ID: 2, Name: "build-2", RemoteID: "def"},
+			}
+			return nil
+		}).Times(2)
+	d.EXPECT().DeploymentByRemoteID(ctx, "def", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string,
+			out *schema.Deployment) error {
+			*out = schema.Deployment{ID: 2, Name: "build-2", RemoteID: "def",
+				BuildLog: "done"}
+			return nil
+		})
+	deployment, err := lagoon.GetDeploymentByName(ctx, d, "bananas", "master",
+		"build-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deployment.BuildLog != "done" {
+		t.Fatalf("unexpected deployment: %v", deployment)
+	}
+	_, err = lagoon.GetDeploymentByName(ctx, d, "bananas", "master", "build-3")
+	if !errors.Is(err, lagoon.ErrNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestFollowDeploymentLog(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockDeploymentLogs(ctrl)
+	polls := []schema.Deployment{
+		{ID: 1, RemoteID: "abc", Status: api.RunningDeploy,
+			BuildLog: "step 1\nstep 2\n"},
+		{ID: 1, RemoteID: "abc", Status: "failed",
+			BuildLog: "step 1\nstep 2\nstep 3 failed"},
+	}
+	gomock.InOrder(
+		d.EXPECT().DeploymentByRemoteID(ctx, "abc", gomock.Any()).
+			SetArg(2, polls[0]),
+		d.EXPECT().DeploymentByRemoteID(ctx, "abc", gomock.Any()).
+			SetArg(2, polls[1]),
+	)
+	var out bytes.Buffer
+	deployment, err := lagoon.FollowDeploymentLog(ctx, d, &schema.Deployment{
+		ID: 1, RemoteID: "abc", Status: api.PendingDeploy,
+		BuildLog: "step 1\n",
+	}, time.Millisecond, &out)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deployment.Status != "failed" {
+		t.Fatalf("unexpected status %v", deployment.Status)
+	}
+	expected := "step 1\nstep 2\nstep 3 failed\n"
+	if out.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, out.String())
+	}
+}
+
+func TestWaitForDeployment(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	d := mock.NewMockDeployments(ctrl)
+	old := schema.Deployment{ID: 1, Name: "build-1", Status: "complete"}
+	polls := [][]schema.Deployment{
+		{old},
+		{old, {ID: 2, Name: "build-2", Status: "pending"}},
+		{old, {ID: 2, Name: "build-2", Status: "running"}},
+		{old, {ID: 2, Name: "build-2", Status: "running"}},
+		{old, {ID: 2, Name: "build-2", Status: "failed"}},
+	}
+	d.EXPECT().ProjectInfoByName(ctx, "bananas", gomock.Any()).
+		DoAndReturn(setProject(bananas(7)))
+	var calls []*gomock.Call
+	for _, poll := range polls {
+		calls = append(calls, d.EXPECT().
+			DeploymentsByEnvironment(ctx, "master", uint(7), gomock.Any()).
+			SetArg(3, poll))
+	}
+	gomock.InOrder(calls...)
+	var statuses []api.DeploymentStatusType
+	deployment, err := lagoon.WaitForDeployment(ctx, d, "bananas", "master",
+		map[string]bool{"build-1": true}, time.Millisecond,
+		func(deployment *schema.Deployment) {
+			statuses = append(statuses, deployment.Status)
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deployment.Name != "build-2" || deployment.Status != "failed" {
+		t.Fatalf("unexpected deployment: %v", deployment)
+	}
+	expected := []api.DeploymentStatusType{"pending", "running", "failed"}
+	if !reflect.DeepEqual(statuses, expected) {
+		t.Fatalf("expected progress %v, got %v", expected, statuses)
+	}
+}
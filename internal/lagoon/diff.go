@@ -0,0 +1,404 @@
+package lagoon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+// DiffConfig compares the projects in a configuration object with their
+// current state in the Lagoon API, and returns a unified diff between the
+// normalised YAML of each. Only objects attached to the projects in the
+// configuration are visible in the current state. Secret values are masked,
+// and those which would change are marked as such. The returned diff is empty
+// if there are no differences.
+func DiffConfig(ctx context.Context, e Exporter, config *schema.Config,
+	exclude map[string]bool) (string, error) {
+
+	var projects []schema.Project
+	for _, p := range config.Projects {
+		project := schema.Project{}
+		if err := e.ProjectByName(ctx, p.Name, &project); err != nil {
+			return "", fmt.Errorf(
+				`couldn't get project "%s" by name: %w`, p.Name, err)
+		}
+		if project.ID == 0 {
+			continue // project doesn't exist yet
+		}
+		projects = append(projects, project)
+	}
+	data, err := schema.ProjectsToConfig(projects, exclude)
+	if err != nil {
+		return "", fmt.Errorf("couldn't export current config: %w", err)
+	}
+	current := schema.Config{}
+	if err = schema.UnmarshalConfigYAML(data, &current); err != nil {
+		return "", fmt.Errorf("couldn't unmarshal current config: %w", err)
+	}
+	filterConfig(&current, config)
+	// secret values are masked, but those which differ are marked as changed
+	currentSecrets := map[string]string{}
+	from, err := normaliseConfig(&current, exclude,
+		func(name, value string) string {
+			currentSecrets[name] = value
+			return sensitiveValue
+		})
+	if err != nil {
+		return "", err
+	}
+	to, err := normaliseConfig(config, exclude,
+		func(name, value string) string {
+			if old, ok := currentSecrets[name]; ok && old != value {
+				return sensitiveValue + " (changed)"
+			}
+			return sensitiveValue
+		})
+	if err != nil {
+		return "", err
+	}
+	return unifiedDiff("lagoon", "config", splitLines(from), splitLines(to), 3),
+		nil
+}
+
+// filterConfig removes top-level groups, users and notifications from current
+// which are not in want. These are exported with the projects which refer to
+// them, but are not managed unless they are in the configuration.
+func filterConfig(current, want *schema.Config) {
+	names := map[string]bool{}
+	for _, g := range want.Groups {
+		names["group/"+g.Name] = true
+	}
+	for _, bg := range want.BillingGroups {
+		names["billingGroup/"+bg.Name] = true
+	}
+	for _, u := range want.Users {
+		names["user/"+u.Email] = true
+	}
+	if want.Notifications != nil {
+		for _, n := range want.Notifications.Slack {
+			names["slack/"+n.Name] = true
+		}
+		for _, n := range want.Notifications.RocketChat {
+			names["rocketChat/"+n.Name] = true
+		}
+		for _, n := range want.Notifications.Email {
+			names["email/"+n.Name] = true
+		}
+		for _, n := range want.Notifications.MicrosoftTeams {
+			names["microsoftTeams/"+n.Name] = true
+		}
+	}
+	var groups []schema.GroupConfig
+	for _, g := range current.Groups {
+		if names["group/"+g.Name] {
+			groups = append(groups, g)
+		}
+	}
+	current.Groups = groups
+	var billingGroups []schema.AddBillingGroupInput
+	for _, bg := range current.BillingGroups {
+		if names["billingGroup/"+bg.Name] {
+			billingGroups = append(billingGroups, bg)
+		}
+	}
+	current.BillingGroups = billingGroups
+	var users []schema.User
+	for _, u := range current.Users {
+		if names["user/"+u.Email] {
+			users = append(users, u)
+		}
+	}
+	current.Users = users
+	if n := current.Notifications; n != nil {
+		var slack []schema.AddNotificationSlackInput
+		for _, x := range n.Slack {
+			if names["slack/"+x.Name] {
+				slack = append(slack, x)
+			}
+		}
+		n.Slack = slack
+		var rocketChat []schema.AddNotificationRocketChatInput
+		for _, x := range n.RocketChat {
+			if names["rocketChat/"+x.Name] {
+				rocketChat = append(rocketChat, x)
+			}
+		}
+		n.RocketChat = rocketChat
+		var email []schema.AddNotificationEmailInput
+		for _, x := range n.Email {
+			if names["email/"+x.Name] {
+				email = append(email, x)
+			}
+		}
+		n.Email = email
+		var microsoftTeams []schema.AddNotificationMicrosoftTeamsInput
+		for _, x := range n.MicrosoftTeams {
+			if names["microsoftTeams/"+x.Name] {
+				microsoftTeams = append(microsoftTeams, x)
+			}
+		}
+		n.MicrosoftTeams = microsoftTeams
+	}
+}
+
+// sensitiveValue replaces secret values in a diff.
+const sensitiveValue = "(sensitive)"
+
+// normaliseConfig returns a minimised and sorted copy of the config as YAML,
+// so that it can be compared with another config. Secret values in the copy
+// are replaced with the value returned by mask.
+func normaliseConfig(config *schema.Config, exclude map[string]bool,
+	mask func(name, value string) string) ([]byte, error) {
+	// copy the config so that the original is not modified
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal config: %w", err)
+	}
+	c := schema.Config{}
+	if err = json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal config: %w", err)
+	}
+	c.MaskSecrets(mask)
+	sort.Slice(c.Projects, func(i, j int) bool {
+		return c.Projects[i].Name < c.Projects[j].Name
+	})
+	for i := range c.Projects {
+		p := &c.Projects[i]
+		sortEnvVariables(p.EnvVariables)
+		sort.Slice(p.Environments, func(i, j int) bool {
+			return p.Environments[i].Name < p.Environments[j].Name
+		})
+		for j := range p.Environments {
+			env := &p.Environments[j]
+			sortEnvVariables(env.EnvVariables)
+			// clear fields which are not managed by config
+			env.AutoIdle = 0
+			env.Route, env.Routes = "", ""
+			env.Created, env.Updated, env.Deleted = "", "", ""
+		}
+		sort.Strings(p.Groups)
+		sort.Strings(p.BillingGroups)
+		sortUserRoles(p.Users)
+		if p.Notifications != nil {
+			sort.Strings(p.Notifications.Slack)
+			sort.Strings(p.Notifications.RocketChat)
+			sort.Strings(p.Notifications.Email)
+			sort.Strings(p.Notifications.MicrosoftTeams)
+		}
+	}
+	sort.Slice(c.Groups, func(i, j int) bool {
+		return c.Groups[i].Name < c.Groups[j].Name
+	})
+	for i := range c.Groups {
+		sortUserRoles(c.Groups[i].Users)
+	}
+	sort.Slice(c.BillingGroups, func(i, j int) bool {
+		return c.BillingGroups[i].Name < c.BillingGroups[j].Name
+	})
+	sort.Slice(c.Users, func(i, j int) bool {
+		return c.Users[i].Email < c.Users[j].Email
+	})
+	for i := range c.Users {
+		keys := c.Users[i].SSHKeys
+		sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+	}
+	if n := c.Notifications; n != nil {
+		sort.Slice(n.Slack, func(i, j int) bool {
+			return n.Slack[i].Name < n.Slack[j].Name
+		})
+		sort.Slice(n.RocketChat, func(i, j int) bool {
+			return n.RocketChat[i].Name < n.RocketChat[j].Name
+		})
+		sort.Slice(n.Email, func(i, j int) bool {
+			return n.Email[i].Name < n.Email[j].Name
+		})
+		sort.Slice(n.MicrosoftTeams, func(i, j int) bool {
+			return n.MicrosoftTeams[i].Name < n.MicrosoftTeams[j].Name
+		})
+	}
+	data, err = schema.MarshalConfigYAML(&c, exclude)
+	if err != nil {
+		return nil, err
+	}
+	if string(data) == "{}\n" {
+		return nil, nil // empty config
+	}
+	return data, nil
+}
+
+func sortEnvVariables(evs []schema.EnvKeyValue) {
+	sort.Slice(evs, func(i, j int) bool { return evs[i].Name < evs[j].Name })
+}
+
+func sortUserRoles(users []schema.UserRoleConfig) {
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Email < users[j].Email
+	})
+}
+
+// splitLines splits data into lines, ignoring a trailing newline.
+func splitLines(data []byte) []string {
+	s := strings.TrimSuffix(string(data), "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// maxDiffCells caps the size of the table used to find the longest common
+// subsequence of two lists of lines, so that large configs with many changes
+// don't use quadratic memory.
+const maxDiffCells = 1 << 20
+
+// diffOp is a line of an edit script: ' ' for a common line, '-' for a line
+// removed from a and '+' for a line added from b.
+type diffOp struct {
+	kind byte
+	line string
+}
+
+// editScript returns an edit script which turns a into b. Lines common to the
+// start and end of a and b are trimmed before the longest common subsequence
+// of the remaining lines is found. If the remaining lines are too many to
+// compare within maxDiffCells, they are all replaced instead.
+func editScript(a, b []string) []diffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	var ops []diffOp
+	for _, line := range a[:prefix] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	am, bm := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if (len(am)+1)*(len(bm)+1) > maxDiffCells {
+		for _, line := range am {
+			ops = append(ops, diffOp{'-', line})
+		}
+		for _, line := range bm {
+			ops = append(ops, diffOp{'+', line})
+		}
+	} else {
+		ops = append(ops, lcsEditScript(am, bm)...)
+	}
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	return ops
+}
+
+// lcsEditScript returns a minimal edit script which turns a into b, using a
+// table of the longest common subsequences of their suffixes.
+func lcsEditScript(a, b []string) []diffOp {
+	// lcs[i*w+j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	w := len(b) + 1
+	lcs := make([]int, (len(a)+1)*w)
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i*w+j] = lcs[(i+1)*w+j+1] + 1
+			} else if lcs[(i+1)*w+j] >= lcs[i*w+j+1] {
+				lcs[i*w+j] = lcs[(i+1)*w+j]
+			} else {
+				lcs[i*w+j] = lcs[i*w+j+1]
+			}
+		}
+	}
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[(i+1)*w+j] >= lcs[i*w+j+1]):
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	return ops
+}
+
+// unifiedDiff returns the differences between the lines a and b in unified
+// diff format, with n lines of context. The returned diff is empty if a and b
+// are equal.
+func unifiedDiff(fromName, toName string, a, b []string, n int) string {
+	ops := editScript(a, b)
+	var changes []int
+	for k, o := range ops {
+		if o.kind != ' ' {
+			changes = append(changes, k)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+	// group the changes into hunks of ops, with n lines of context
+	type hunk struct{ start, end int }
+	var hunks []hunk
+	for _, c := range changes {
+		start, end := c-n, c+n+1
+		if start < 0 {
+			start = 0
+		}
+		if end > len(ops) {
+			end = len(ops)
+		}
+		if len(hunks) > 0 && start <= hunks[len(hunks)-1].end {
+			hunks[len(hunks)-1].end = end
+			continue
+		}
+		hunks = append(hunks, hunk{start, end})
+	}
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromName, toName)
+	// aLine and bLine count the lines of a and b before the current op
+	aLine, bLine, k := 0, 0, 0
+	for _, h := range hunks {
+		for ; k < h.start; k++ {
+			aLine++
+			bLine++
+		}
+		aLen, bLen := 0, 0
+		for _, o := range ops[h.start:h.end] {
+			if o.kind != '+' {
+				aLen++
+			}
+			if o.kind != '-' {
+				bLen++
+			}
+		}
+		aStart, bStart := aLine+1, bLine+1
+		if aLen == 0 {
+			aStart = aLine
+		}
+		if bLen == 0 {
+			bStart = bLine
+		}
+		fmt.Fprintf(&out, "@@ -%d,%d +%d,%d @@\n", aStart, aLen, bStart, bLen)
+		for ; k < h.end; k++ {
+			fmt.Fprintf(&out, "%c%s\n", ops[k].kind, ops[k].line)
+			if ops[k].kind != '+' {
+				aLine++
+			}
+			if ops[k].kind != '-' {
+				bLine++
+			}
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,44 @@
+package lagoon
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	lines := func(prefix string, n int) []string {
+		l := make([]string, n)
+		for i := range l {
+			l[i] = fmt.Sprintf("%s%d", prefix, i)
+		}
+		return l
+	}
+	// a large file with a single change is trimmed to that change
+	a := lines("line", 100000)
+	b := append([]string(nil), a...)
+	b[50000] = "changed"
+	expect := `--- a
++++ b
+@@ -49998,7 +49998,7 @@
+ line49997
+ line49998
+ line49999
+-line50000
++changed
+ line50001
+ line50002
+ line50003
+`
+	if result := unifiedDiff("a", "b", a, b, 3); result != expect {
+		t.Errorf("result:\n%s\nexpected:\n%s", result, expect)
+	}
+	// a large file with many changes is replaced rather than compared line by
+	// line
+	a, b = lines("a", 5000), lines("b", 5000)
+	result := unifiedDiff("a", "b", a, b, 3)
+	if !strings.HasPrefix(result, "--- a\n+++ b\n@@ -1,5000 +1,5000 @@\n-a0\n") ||
+		!strings.HasSuffix(result, "+b4999\n") {
+		t.Errorf("unexpected diff of replaced files:\n%.200s", result)
+	}
+}
@@ -0,0 +1,80 @@
+package lagoon_test
+
+import (
+	"context"
+	"flag"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/mock"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/golang/mock/gomock"
+)
+
+var update = flag.Bool("update", false, "update .golden files")
+
+func TestDiffConfig(t *testing.T) {
+	var testCases = map[string]struct {
+		input   string
+		current func() schema.Project
+		expect  string
+	}{
+		"existingProject": {
+			input:   "testdata/apply.yaml",
+			current: currentBananas,
+			expect:  "testdata/existingProject.golden.diff",
+		},
+		"newProject": {
+			input:   "testdata/apply.yaml",
+			current: func() schema.Project { return schema.Project{} },
+			expect:  "testdata/newProject.golden.diff",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			ctx := context.Background()
+			// set up the mock exporter
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+			exporter := mock.NewMockApplier(ctrl)
+			exporter.EXPECT().ProjectByName(ctx, "bananas", gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, project *schema.Project) error {
+					*project = tc.current()
+					return nil
+				})
+			// read the config
+			file, err := os.Open(tc.input)
+			if err != nil {
+				tt.Fatalf("couldn't open file: %v", err)
+			}
+			defer file.Close()
+			config, err := lagoon.ParseConfig(file)
+			if err != nil {
+				tt.Fatalf("couldn't parse config: %v", err)
+			}
+			result, err := lagoon.DiffConfig(ctx, exporter, config, nil)
+			if err != nil {
+				tt.Fatalf("couldn't diff config: %v", err)
+			}
+
+			if *update {
+				tt.Logf("update golden file: %s", tc.expect)
+				err = ioutil.WriteFile(tc.expect, []byte(result), 0644)
+				if err != nil {
+					tt.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+
+			expected, err := ioutil.ReadFile(tc.expect)
+			if err != nil {
+				tt.Fatalf("failed reading golden file: %v", err)
+			}
+			if result != string(expected) {
+				tt.Logf("result:\n%s\nexpected:\n%s", result, expected)
+				tt.Errorf("result does not match expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package lagoon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+// ProjectInfo interface contains methods for getting info on projects.
+type ProjectInfo interface {
+	ProjectInfoByName(ctx context.Context, name string,
+		project *schema.Project) error
+}
+
+// Environments interface contains methods for getting info on projects and
+// their environments.
+type Environments interface {
+	ProjectInfo
+	EnvironmentByName(ctx context.Context, name string, projectID uint,
+		environment *schema.Environment) error
+}
+
+// GetProjectInfo gets info on a project by name.
+func GetProjectInfo(
+	ctx context.Context, p ProjectInfo, name string) (*schema.Project, error) {
+	project := schema.Project{}
+	if err := p.ProjectInfoByName(ctx, name, &project); err != nil {
+		return nil, err
+	}
+	if project.Name == "" {
+		return nil, fmt.Errorf(`project "%s" %w`, name, ErrNotFound)
+	}
+	return &project, nil
+}
+
+// GetEnvironment gets info on an environment by project and environment
+// name.
+func GetEnvironment(ctx context.Context, e Environments,
+	projectName, environmentName string) (*schema.Environment, error) {
+	project, err := GetProjectInfo(ctx, e, projectName)
+	if err != nil {
+		return nil, err
+	}
+	environment := schema.Environment{}
+	err = e.EnvironmentByName(ctx, environmentName, project.ID, &environment)
+	if err != nil {
+		return nil, err
+	}
+	if environment.ID == 0 {
+		return nil, fmt.Errorf(`environment "%s" %w in project "%s"`,
+			environmentName, ErrNotFound, projectName)
+	}
+	return &environment, nil
+}
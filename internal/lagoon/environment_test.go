@@ -0,0 +1,97 @@
+//go:generate mockgen -source=environment.go -destination=../mock/mock_environments.go -package=mock
+package lagoon_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/mock"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/golang/mock/gomock"
+)
+
+// setProject returns a function which can be passed to DoAndReturn to set
+// the project returned by a ProjectInfoByName call.
+func setProject(project schema.Project) func(context.Context, string,
+	*schema.Project) error {
+	return func(_ context.Context, _ string, p *schema.Project) error {
+		*p = project
+		return nil
+	}
+}
+
+// setEnvironment returns a function which can be passed to DoAndReturn to
+// set the environment returned by an EnvironmentByName call.
+func setEnvironment(environment schema.Environment) func(context.Context,
+	string, uint, *schema.Environment) error {
+	return func(_ context.Context, _ string, _ uint,
+		e *schema.Environment) error {
+		*e = environment
+		return nil
+	}
+}
+
+// bananas returns a minimal bananas project with the given ID.
+func bananas(id uint) schema.Project {
+	project := schema.Project{}
+	project.ID = id
+	project.Name = "bananas"
+	return project
+}
+
+// master returns a minimal master environment with the given ID.
+func master(id uint) schema.Environment {
+	environment := schema.Environment{}
+	environment.ID = id
+	environment.Name = "master"
+	return environment
+}
+
+func TestGetEnvironment(t *testing.T) {
+	var testCases = map[string]struct {
+		project     schema.Project
+		environment schema.Environment
+		expectErr   string
+	}{
+		"found": {
+			project:     bananas(7),
+			environment: master(8),
+		},
+		"noProject": {
+			expectErr: `project "bananas" not found`,
+		},
+		"noEnvironment": {
+			project:   bananas(7),
+			expectErr: `environment "master" not found in project "bananas"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+			e := mock.NewMockEnvironments(ctrl)
+			e.EXPECT().ProjectInfoByName(ctx, "bananas", gomock.Any()).
+				DoAndReturn(setProject(tc.project))
+			if tc.project.ID != 0 {
+				e.EXPECT().EnvironmentByName(ctx, "master", tc.project.ID,
+					gomock.Any()).DoAndReturn(setEnvironment(tc.environment))
+			}
+			environment, err := lagoon.GetEnvironment(ctx, e, "bananas", "master")
+			if tc.expectErr != "" {
+				if err == nil || err.Error() != tc.expectErr {
+					tt.Fatalf("expected error %q, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if environment.ID != tc.environment.ID {
+				tt.Fatalf("expected environment %d, got %d",
+					tc.environment.ID, environment.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package lagoon
+
+import "errors"
+
+// These errors classify the errors returned by the Lagoon API, so that callers
+// can check for them with errors.Is.
+var (
+	// ErrExist indicates that an attempt was made to create an object that
+	// already exists.
+	ErrExist = errors.New("object already exists")
+	// ErrNotFound indicates that the requested object doesn't exist.
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized indicates that the API rejected the token, e.g. because
+	// it has expired.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden indicates that the user doesn't have permission to perform
+	// the requested operation.
+	ErrForbidden = errors.New("permission denied")
+)
@@ -6,30 +6,125 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 
 	"github.com/amazeeio/lagoon-cli/internal/schema"
 )
 
+// exportConcurrency is the maximum number of projects queried concurrently
+// during export.
+const exportConcurrency = 8
+
 // Exporter interface contains methods for exporting data from Lagoon.
 type Exporter interface {
 	ProjectByName(ctx context.Context, name string, project *schema.Project) error
 }
 
+// ProjectLister interface contains methods for listing projects in Lagoon.
+type ProjectLister interface {
+	AllProjects(ctx context.Context, projects *[]schema.Project) error
+	ProjectsByGroup(
+		ctx context.Context, group string, projects *[]schema.Project) error
+}
+
 // ExportProject exports the given project by name.
 func ExportProject(ctx context.Context,
 	e Exporter, name string, exclude map[string]bool) ([]byte, error) {
+	return ExportProjects(ctx, e, []string{name}, exclude)
+}
+
+// ExportProjects exports the given projects by name to a single config.
+// Projects are queried concurrently, and objects shared between projects such
+// as groups, users, billing groups and notifications appear only once in the
+// config.
+func ExportProjects(ctx context.Context,
+	e Exporter, names []string, exclude map[string]bool) ([]byte, error) {
 
-	project := schema.Project{}
+	names = uniqueSorted(names)
+	projects := make([]schema.Project, len(names))
+	errs := make([]error, len(names))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, exportConcurrency)
+	for i := range names {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			errs[i] = exportProject(ctx, e, names[i], &projects[i])
+		}(i)
+	}
+	wg.Wait()
+	// return the first error in name order so the result is deterministic
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schema.ProjectsToConfig(projects, exclude)
+}
 
-	err := e.ProjectByName(ctx, name, &project)
+// exportProject queries a single project by name for export.
+func exportProject(ctx context.Context,
+	e Exporter, name string, project *schema.Project) error {
+
+	err := e.ProjectByName(ctx, name, project)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't perform request: %w", err)
+		return fmt.Errorf("couldn't perform request: %w", err)
+	}
+	if project.Name == "" {
+		return fmt.Errorf(`project "%s" %w`, name, ErrNotFound)
 	}
 
 	// sort EnvVariables by name
 	sort.Slice(project.EnvVariables, func(i, j int) bool {
 		return project.EnvVariables[i].Name < project.EnvVariables[j].Name
 	})
+	return nil
+}
 
-	return schema.ProjectsToConfig([]schema.Project{project}, exclude)
+// ProjectNames returns the names of all projects if all is true, or else the
+// names of the projects in the given groups. The names are sorted and unique.
+func ProjectNames(ctx context.Context,
+	l ProjectLister, all bool, groups []string) ([]string, error) {
+
+	var names []string
+	if all {
+		projects := []schema.Project{}
+		if err := l.AllProjects(ctx, &projects); err != nil {
+			return nil, fmt.Errorf("couldn't list all projects: %w", err)
+		}
+		for _, p := range projects {
+			names = append(names, p.Name)
+		}
+		return uniqueSorted(names), nil
+	}
+	for _, group := range groups {
+		projects := []schema.Project{}
+		if err := l.ProjectsByGroup(ctx, group, &projects); err != nil {
+			return nil, fmt.Errorf(
+				`couldn't list projects in group "%s": %w`, group, err)
+		}
+		for _, p := range projects {
+			names = append(names, p.Name)
+		}
+	}
+	return uniqueSorted(names), nil
+}
+
+// uniqueSorted returns a sorted copy of s with duplicates removed.
+func uniqueSorted(s []string) []string {
+	seen := map[string]bool{}
+	var u []string
+	for _, v := range s {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		u = append(u, v)
+	}
+	sort.Strings(u)
+	return u
 }
@@ -0,0 +1,153 @@
+//go:generate mockgen -source=export.go -destination=../mock/mock_exporter.go -package=mock
+
+package lagoon_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/mock"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+	"github.com/golang/mock/gomock"
+)
+
+// sharedGroupProject returns a project which is a member of the shared group
+// abc.
+func sharedGroupProject(name string) schema.Project {
+	abc := schema.Group{AddGroupInput: schema.AddGroupInput{Name: "abc"}}
+	abc.Members = append(abc.Members, struct {
+		User schema.User   `json:"user"`
+		Role api.GroupRole `json:"role"`
+	}{
+		User: schema.User{AddUserInput: schema.AddUserInput{
+			Email: "foo@example.com",
+		}},
+		Role: api.OwnerRole,
+	})
+	project := schema.Project{
+		Groups: &schema.Groups{Groups: []schema.Group{abc}},
+		Notifications: &schema.Notifications{
+			Slack: []schema.AddNotificationSlackInput{{
+				Name:    "shared-slack",
+				Webhook: "https://example.com/hook",
+				Channel: "shared",
+			}},
+		},
+	}
+	project.ID = 1
+	project.Name = name
+	project.GitURL = "git@github.com:amazeeio/" + name + ".git"
+	project.ProductionEnvironment = "master"
+	return project
+}
+
+func TestExportProjects(t *testing.T) {
+	var testCases = map[string]struct {
+		names  []string
+		expect string
+	}{
+		"sharedGroup": {
+			names:  []string{"bananas", "apples", "bananas"},
+			expect: "testdata/sharedGroup.golden.yaml",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			ctx := context.Background()
+			// set up the mock exporter
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+			exporter := mock.NewMockExporter(ctrl)
+			exporter.EXPECT().ProjectByName(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, name string,
+					project *schema.Project) error {
+					*project = sharedGroupProject(name)
+					return nil
+				}).Times(2)
+			result, err := lagoon.ExportProjects(ctx, exporter, tc.names, nil)
+			if err != nil {
+				tt.Fatalf("couldn't export projects: %v", err)
+			}
+
+			if *update {
+				tt.Logf("update golden file: %s", tc.expect)
+				if err = ioutil.WriteFile(tc.expect, result, 0644); err != nil {
+					tt.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+
+			expected, err := ioutil.ReadFile(tc.expect)
+			if err != nil {
+				tt.Fatalf("failed reading golden file: %v", err)
+			}
+			if !bytes.Equal(result, expected) {
+				tt.Logf("result:\n%s\nexpected:\n%s", result, expected)
+				tt.Errorf("result does not match expected")
+			}
+		})
+	}
+}
+
+func TestExportProjectsNotFound(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	exporter := mock.NewMockExporter(ctrl)
+	exporter.EXPECT().ProjectByName(ctx, "bananas", gomock.Any())
+	_, err := lagoon.ExportProjects(ctx, exporter, []string{"bananas"}, nil)
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
+
+func TestProjectNames(t *testing.T) {
+	setNames := func(names ...string) func(context.Context, string,
+		*[]schema.Project) error {
+		return func(_ context.Context, _ string, projects *[]schema.Project) error {
+			for _, name := range names {
+				p := schema.Project{}
+				p.Name = name
+				*projects = append(*projects, p)
+			}
+			return nil
+		}
+	}
+	var testCases = map[string]struct {
+		all    bool
+		groups []string
+		expect []string
+	}{
+		"all":    {all: true, expect: []string{"apples", "bananas", "pears"}},
+		"groups": {groups: []string{"abc", "xyz"}, expect: []string{"apples", "bananas"}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+			lister := mock.NewMockProjectLister(ctrl)
+			if tc.all {
+				lister.EXPECT().AllProjects(ctx, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, projects *[]schema.Project) error {
+						return setNames("pears", "bananas", "apples")(ctx, "", projects)
+					})
+			}
+			lister.EXPECT().ProjectsByGroup(ctx, "abc", gomock.Any()).
+				DoAndReturn(setNames("bananas", "apples")).AnyTimes()
+			lister.EXPECT().ProjectsByGroup(ctx, "xyz", gomock.Any()).
+				DoAndReturn(setNames("bananas")).AnyTimes()
+			names, err := lagoon.ProjectNames(ctx, lister, tc.all, tc.groups)
+			if err != nil {
+				tt.Fatalf("couldn't list project names: %v", err)
+			}
+			if !reflect.DeepEqual(names, tc.expect) {
+				tt.Fatalf("expected %v, got %v", tc.expect, names)
+			}
+		})
+	}
+}
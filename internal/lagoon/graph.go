@@ -0,0 +1,171 @@
+package lagoon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"sort"
+)
+
+// abortError wraps an error which aborts the import even if keepGoing is set.
+type abortError struct {
+	error
+}
+
+// Unwrap returns the wrapped error.
+func (e abortError) Unwrap() error {
+	return e.error
+}
+
+// importTask is a single mutation (or a short sequence of dependent calls)
+// in an importGraph.
+type importTask struct {
+	index int
+	run   func(context.Context, *log.Logger) error
+	// parent is the task which created the object this task refers to. If the
+	// parent fails the task is skipped.
+	parent *importTask
+	// pending is the number of dependencies which haven't finished yet.
+	pending    int
+	dependents []*importTask
+	finished   bool
+	// failed is set if the task or its parent failed.
+	failed bool
+	err    error
+	logs   bytes.Buffer
+	l      *log.Logger
+}
+
+// importGraph is a directed acyclic graph of import tasks. Tasks are added
+// in the order they would be run sequentially, and a task's dependencies must
+// be added before it.
+type importGraph struct {
+	tasks []*importTask
+}
+
+// add adds a task to the graph. The task runs after parent and after, and is
+// skipped if parent fails. Nil dependencies are ignored, so that objects which
+// aren't in the config can be referred to.
+func (g *importGraph) add(parent *importTask, after []*importTask,
+	run func(context.Context, *log.Logger) error) *importTask {
+	t := &importTask{
+		index:  len(g.tasks),
+		run:    run,
+		parent: parent,
+	}
+	t.l = log.New(&t.logs, "import: ", 0)
+	seen := map[*importTask]bool{}
+	for _, dep := range append([]*importTask{parent}, after...) {
+		if dep == nil || seen[dep] {
+			continue
+		}
+		seen[dep] = true
+		dep.dependents = append(dep.dependents, t)
+		t.pending++
+	}
+	g.tasks = append(g.tasks, t)
+	return t
+}
+
+// run runs the tasks in the graph using up to parallel concurrent workers.
+// Of the tasks whose dependencies have finished, the one added first is
+// always run next, so with parallel set to 1 the tasks run in the order they
+// were added. The log output of each task is written to out in the order the
+// tasks were added, regardless of the order in which they finish.
+//
+// If keepGoing is false, no new tasks are started after a task fails. The
+// error returned is that of the first failed task in the order the tasks were
+// added, so that it is deterministic. No new tasks are started once ctx is
+// done, regardless of keepGoing.
+func (g *importGraph) run(ctx context.Context, parallel int, keepGoing bool,
+	out io.Writer) error {
+	if parallel < 1 {
+		parallel = 1
+	}
+	var ready []*importTask // sorted by index
+	push := func(t *importTask) {
+		i := sort.Search(len(ready), func(i int) bool {
+			return ready[i].index > t.index
+		})
+		ready = append(ready, nil)
+		copy(ready[i+1:], ready[i:])
+		ready[i] = t
+	}
+	for _, t := range g.tasks {
+		if t.pending == 0 {
+			push(t)
+		}
+	}
+
+	var next, running int
+	var aborted bool
+	finish := func(t *importTask) {
+		t.finished = true
+		for _, d := range t.dependents {
+			d.pending--
+			if d.pending == 0 {
+				push(d)
+			}
+		}
+		if t.err != nil && (!keepGoing || errors.As(t.err, &abortError{})) {
+			aborted = true
+		}
+		// flush logs in order
+		for next < len(g.tasks) && g.tasks[next].finished {
+			_, _ = out.Write(g.tasks[next].logs.Bytes())
+			next++
+		}
+	}
+
+	results := make(chan *importTask)
+	for {
+		for !aborted && ctx.Err() == nil && running < parallel && len(ready) > 0 {
+			t := ready[0]
+			ready = ready[1:]
+			if t.parent != nil && t.parent.failed {
+				t.failed = true
+				finish(t)
+				continue
+			}
+			running++
+			go func(t *importTask) {
+				t.err = t.run(ctx, t.l)
+				results <- t
+			}(t)
+		}
+		if running == 0 {
+			break
+		}
+		t := <-results
+		running--
+		if t.err != nil {
+			t.failed = true
+			if keepGoing && !errors.As(t.err, &abortError{}) {
+				t.l.Print(t.err)
+			}
+		}
+		finish(t)
+	}
+	// flush the logs of tasks which finished after an earlier task was skipped
+	for _, t := range g.tasks[next:] {
+		if t.finished {
+			_, _ = out.Write(t.logs.Bytes())
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	for _, t := range g.tasks {
+		var ae abortError
+		if errors.As(t.err, &ae) {
+			return ae.error
+		}
+		if t.err != nil && !keepGoing {
+			return t.err
+		}
+	}
+	return nil
+}
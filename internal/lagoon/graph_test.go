@@ -0,0 +1,123 @@
+package lagoon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestImportGraphRun(t *testing.T) {
+	var testCases = map[string]struct {
+		parallel  int
+		keepGoing bool
+		fail      map[int]bool
+		cancel    map[int]bool
+		expectRun []int // only checked if parallel is 1
+		expectLog string
+		expectErr string
+	}{
+		"sequential": {
+			parallel:  1,
+			expectRun: []int{0, 1, 2, 3, 4, 5},
+			expectLog: "import: task 0\nimport: task 1\nimport: task 2\n" +
+				"import: task 3\nimport: task 4\nimport: task 5\n",
+		},
+		"parallel": {
+			parallel: 4,
+			expectLog: "import: task 0\nimport: task 1\nimport: task 2\n" +
+				"import: task 3\nimport: task 4\nimport: task 5\n",
+		},
+		"skip children of failed parent": {
+			parallel:  1,
+			keepGoing: true,
+			fail:      map[int]bool{2: true},
+			expectRun: []int{0, 1, 2, 4, 5},
+			expectLog: "import: task 0\nimport: task 1\nimport: task 2\n" +
+				"import: task 2 failed\nimport: task 4\nimport: task 5\n",
+		},
+		"abort": {
+			parallel:  4,
+			fail:      map[int]bool{1: true, 2: true},
+			expectErr: "task 1 failed",
+		},
+		"cancel": {
+			parallel:  1,
+			keepGoing: true,
+			cancel:    map[int]bool{1: true},
+			expectRun: []int{0, 1},
+			expectErr: "context canceled",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			var mu sync.Mutex
+			var ran []int
+			var running, maxRunning int
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			g := importGraph{}
+			task := func(i int) func(context.Context, *log.Logger) error {
+				return func(_ context.Context, l *log.Logger) error {
+					mu.Lock()
+					ran = append(ran, i)
+					running++
+					if running > maxRunning {
+						maxRunning = running
+					}
+					mu.Unlock()
+					time.Sleep(time.Millisecond)
+					l.Printf("task %d", i)
+					mu.Lock()
+					running--
+					mu.Unlock()
+					if tc.cancel[i] {
+						cancel()
+					}
+					if tc.fail[i] {
+						return fmt.Errorf("task %d failed", i)
+					}
+					return nil
+				}
+			}
+			// 0 and 1 are independent, 2 depends on 0, 3 is a child of 2, 4
+			// depends on 1 and 2, and 5 is a child of 1.
+			t0 := g.add(nil, nil, task(0))
+			t1 := g.add(nil, nil, task(1))
+			t2 := g.add(nil, []*importTask{t0}, task(2))
+			g.add(t2, nil, task(3))
+			g.add(nil, []*importTask{t1, t2}, task(4))
+			g.add(t1, nil, task(5))
+
+			var out bytes.Buffer
+			err := g.run(ctx, tc.parallel, tc.keepGoing, &out)
+			if tc.expectErr != "" {
+				if err == nil || err.Error() != tc.expectErr {
+					tt.Fatalf("expected error %q, got %v", tc.expectErr, err)
+				}
+				if tc.parallel == 1 && tc.expectRun != nil &&
+					!reflect.DeepEqual(ran, tc.expectRun) {
+					tt.Fatalf("expected run order %v, got %v", tc.expectRun, ran)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if maxRunning > tc.parallel {
+				tt.Fatalf("expected at most %d concurrent tasks, got %d",
+					tc.parallel, maxRunning)
+			}
+			if tc.parallel == 1 && !reflect.DeepEqual(ran, tc.expectRun) {
+				tt.Fatalf("expected run order %v, got %v", tc.expectRun, ran)
+			}
+			if out.String() != tc.expectLog {
+				tt.Fatalf("expected log:\n%s\ngot:\n%s", tc.expectLog, out.String())
+			}
+		})
+	}
+}
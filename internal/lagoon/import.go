@@ -13,10 +13,6 @@ import (
 	"github.com/amazeeio/lagoon-cli/pkg/api"
 )
 
-// ErrExist indicates that an attempt was made to create an object that already
-// exists.
-var ErrExist = errors.New("object already exists")
-
 // Importer interface contains methods for exporting data from Lagoon.
 // TODO: compose this once simpler interfaces are defined.
 type Importer interface {
@@ -44,6 +40,7 @@ type Importer interface {
 	AddOrUpdateEnvironment(
 		context.Context, *schema.AddEnvironmentInput, *schema.Environment) error
 	EnvironmentByName(context.Context, string, uint, *schema.Environment) error
+	GroupByName(context.Context, string, *schema.Groups) error
 	AddGroupsToProject(
 		context.Context, *schema.ProjectGroupsInput, *schema.Project) error
 	AddNotificationToProject(context.Context,
@@ -54,311 +51,514 @@ type Importer interface {
 		*schema.Project) error
 }
 
+// ParseConfig reads a YAML configuration file from r, and resolves any secret
+// references in it with ResolveSecret.
+func ParseConfig(r io.Reader) (*schema.Config, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read file: %w", err)
+	}
+	config, err := unmarshalConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	if err = config.ResolveSecrets(ResolveSecret); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// unmarshalConfig unmarshals a YAML configuration file without resolving
+// secret references.
+func unmarshalConfig(data []byte) (*schema.Config, error) {
+	config := schema.Config{}
+	if err := schema.UnmarshalConfigYAML(data, &config); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal config: %w", err)
+	}
+	return &config, nil
+}
+
 // Import creates objects in the Lagoon API based on a configuration object.
+// The config is validated with schema.ValidateConfigYAML, logging any
+// warnings, and then any secret references in it are resolved with
+// ResolveSecret, before any objects are created. Objects which don't depend
+// on each other are created concurrently using up to parallel workers.
+// If j is not nil, each object created and each failure is recorded in it so
+// that the import can be rolled back. Objects which existed before the import,
+// such as environments which are updated or group members which are already
+// in the group, are not recorded, so that a rollback never deletes them. If
+// keepGoing is true, a summary of the failures recorded in j is logged at the
+// end of the import.
 func Import(ctx context.Context, i Importer, r io.Reader, keepGoing bool,
-	openshiftID uint) error {
+	openshiftID uint, parallel int, j *Journal) error {
 
 	data, err := ioutil.ReadAll(r)
 	if err != nil {
 		return fmt.Errorf("couldn't read file: %w", err)
 	}
-
-	config := schema.Config{}
-	if err = schema.UnmarshalConfigYAML(data, &config); err != nil {
-		return fmt.Errorf("couldn't unmarshal config: %w", err)
+	config, err := unmarshalConfig(data)
+	if err != nil {
+		return err
+	}
+	l := log.New(os.Stderr, "import: ", 0)
+	warnings, err := schema.ValidateConfigYAML(data, false)
+	if err != nil {
+		return fmt.Errorf("invalid config:\n%w", err)
+	}
+	// undeclared objects are assumed to exist in Lagoon already
+	for _, w := range warnings {
+		l.Print(w)
+	}
+	if err = config.ResolveSecrets(ResolveSecret); err != nil {
+		return err
 	}
 
 	// import the config
-	l := log.New(os.Stderr, "import: ", 0)
+	if keepGoing && j != nil {
+		defer logFailures(l, j)
+	}
+	g := importGraph{}
 	// add billing groups
+	billingGroups := map[string]*importTask{}
 	for _, bg := range config.BillingGroups {
-		if err := i.AddBillingGroup(ctx, &bg, nil); err != nil {
-			if !keepGoing {
-				return fmt.Errorf("couldn't add billing group: %w", err)
-			}
-			l.Printf("couldn't add billing group: %v", err)
-		}
+		bg := bg
+		billingGroups[bg.Name] = g.add(nil, nil,
+			func(ctx context.Context, _ *log.Logger) error {
+				err := i.AddBillingGroup(ctx, &bg, nil)
+				j.record(JournalEntry{Kind: "billingGroup", Name: bg.Name}, err)
+				if err != nil {
+					return fmt.Errorf("couldn't add billing group: %w", err)
+				}
+				return nil
+			})
 	}
 	// add groups
+	groups := map[string]*importTask{}
 	for _, group := range config.Groups {
-		if err := i.AddGroup(ctx, &group.AddGroupInput, nil); err != nil {
-			if !keepGoing {
-				return fmt.Errorf("couldn't add group: %w", err)
-			}
-			l.Printf("couldn't add group: %v", err)
-		}
+		group := group
+		groups[group.Name] = g.add(nil, nil,
+			func(ctx context.Context, _ *log.Logger) error {
+				err := i.AddGroup(ctx, &group.AddGroupInput, nil)
+				j.record(JournalEntry{Kind: "group", Name: group.Name}, err)
+				if err != nil {
+					return fmt.Errorf("couldn't add group: %w", err)
+				}
+				return nil
+			})
 	}
 	// add users
+	users := map[string]*importTask{}
 	for _, user := range config.Users {
-		if err := i.AddUser(ctx, &user.AddUserInput, nil); err != nil {
-			if !keepGoing {
-				return fmt.Errorf("couldn't add user: %w", err)
-			}
-			l.Printf("couldn't add user: %v", err)
-		}
+		user := user
+		users[user.Email] = g.add(nil, nil,
+			func(ctx context.Context, _ *log.Logger) error {
+				err := i.AddUser(ctx, &user.AddUserInput, nil)
+				j.record(JournalEntry{Kind: "user", Name: user.Email}, err)
+				if err != nil {
+					return fmt.Errorf("couldn't add user: %w", err)
+				}
+				return nil
+			})
 	}
 	// add ssh-keys to users
 	for _, user := range config.Users {
 		for _, sshKey := range user.SSHKeys {
-			err := i.AddSSHKey(ctx, &schema.AddSSHKeyInput{
-				SSHKey:    sshKey,
-				UserEmail: user.Email,
-			}, nil)
-			if err != nil {
-				if !keepGoing {
-					return fmt.Errorf("couldn't add SSH key: %w", err)
-				}
-				l.Printf("couldn't add SSH key: %v", err)
-			}
+			email, sshKey := user.Email, sshKey
+			g.add(nil, []*importTask{users[email]},
+				func(ctx context.Context, _ *log.Logger) error {
+					err := i.AddSSHKey(ctx, &schema.AddSSHKeyInput{
+						SSHKey:    sshKey,
+						UserEmail: email,
+					}, nil)
+					j.record(JournalEntry{
+						Kind: "sshKey", Name: sshKey.Name, User: email}, err)
+					if err != nil {
+						return fmt.Errorf("couldn't add SSH key: %w", err)
+					}
+					return nil
+				})
 		}
 	}
 	// add users to groups
 	for _, group := range config.Groups {
 		for _, userRole := range group.Users {
-			err := i.AddUserToGroup(ctx, &schema.UserGroupRoleInput{
-				UserEmail: userRole.Email,
-				GroupName: group.Name,
-				GroupRole: userRole.Role,
-			}, nil)
-			if err != nil {
-				if !keepGoing {
-					return fmt.Errorf("couldn't add user to group: %w", err)
-				}
-				l.Printf("couldn't add user to group: %v", err)
-			}
+			groupName, userRole := group.Name, userRole
+			g.add(nil, []*importTask{groups[groupName], users[userRole.Email]},
+				func(ctx context.Context, _ *log.Logger) error {
+					existed, err := isGroupMember(ctx, i, j, groupName, userRole.Email)
+					if err != nil {
+						return err
+					}
+					err = i.AddUserToGroup(ctx, &schema.UserGroupRoleInput{
+						UserEmail: userRole.Email,
+						GroupName: groupName,
+						GroupRole: userRole.Role,
+					}, nil)
+					j.recordCreated(JournalEntry{Kind: "groupMember",
+						Group: groupName, User: userRole.Email}, existed, err)
+					if err != nil {
+						return fmt.Errorf("couldn't add user to group: %w", err)
+					}
+					return nil
+				})
 		}
 	}
+	// notifications are keyed by type and name
+	notifications := map[api.NotificationType]map[string]*importTask{
+		api.SlackNotification:          {},
+		api.RocketChatNotification:     {},
+		api.EmailNotification:          {},
+		api.MicrosoftTeamsNotification: {},
+	}
 	if config.Notifications != nil {
 		// add Slack notifications
 		for _, n := range config.Notifications.Slack {
-			if err := i.AddNotificationSlack(ctx, &n, nil); err != nil {
-				if !keepGoing {
-					return fmt.Errorf("couldn't add Slack notification: %w", err)
-				}
-				l.Printf("couldn't add Slack notification: %v", err)
-			}
+			n := n
+			notifications[api.SlackNotification][n.Name] = g.add(nil, nil,
+				func(ctx context.Context, _ *log.Logger) error {
+					err := i.AddNotificationSlack(ctx, &n, nil)
+					j.record(JournalEntry{Kind: "notificationSlack", Name: n.Name}, err)
+					if err != nil {
+						return fmt.Errorf("couldn't add Slack notification: %w", err)
+					}
+					return nil
+				})
 		}
 		// add RocketChat notifications
 		for _, n := range config.Notifications.RocketChat {
-			if err := i.AddNotificationRocketChat(ctx, &n, nil); err != nil {
-				if !keepGoing {
-					return fmt.Errorf("couldn't add RocketChat notification: %w", err)
-				}
-				l.Printf("couldn't add RocketChat notification: %v", err)
-			}
+			n := n
+			notifications[api.RocketChatNotification][n.Name] = g.add(nil, nil,
+				func(ctx context.Context, _ *log.Logger) error {
+					err := i.AddNotificationRocketChat(ctx, &n, nil)
+					j.record(
+						JournalEntry{Kind: "notificationRocketChat", Name: n.Name}, err)
+					if err != nil {
+						return fmt.Errorf(
+							"couldn't add RocketChat notification: %w", err)
+					}
+					return nil
+				})
 		}
 		// add Email notifications
 		for _, n := range config.Notifications.Email {
-			if err := i.AddNotificationEmail(ctx, &n, nil); err != nil {
-				if !keepGoing {
-					return fmt.Errorf("couldn't add Email notification: %w", err)
-				}
-				l.Printf("couldn't add Email notification: %v", err)
-			}
+			n := n
+			notifications[api.EmailNotification][n.Name] = g.add(nil, nil,
+				func(ctx context.Context, _ *log.Logger) error {
+					err := i.AddNotificationEmail(ctx, &n, nil)
+					j.record(JournalEntry{Kind: "notificationEmail", Name: n.Name}, err)
+					if err != nil {
+						return fmt.Errorf("couldn't add Email notification: %w", err)
+					}
+					return nil
+				})
 		}
 		// add MicrosoftTeams notifications
 		for _, n := range config.Notifications.MicrosoftTeams {
-			if err := i.AddNotificationMicrosoftTeams(ctx, &n, nil); err != nil {
-				if !keepGoing {
-					return fmt.Errorf("couldn't add MicrosoftTeams notification: %w", err)
-				}
-				l.Printf("couldn't add MicrosoftTeams notification: %v", err)
-			}
+			n := n
+			notifications[api.MicrosoftTeamsNotification][n.Name] = g.add(nil, nil,
+				func(ctx context.Context, _ *log.Logger) error {
+					err := i.AddNotificationMicrosoftTeams(ctx, &n, nil)
+					j.record(JournalEntry{
+						Kind: "notificationMicrosoftTeams", Name: n.Name}, err)
+					if err != nil {
+						return fmt.Errorf(
+							"couldn't add MicrosoftTeams notification: %w", err)
+					}
+					return nil
+				})
 		}
 	}
 	// add projects
-	newProj := schema.Project{}
 	for _, p := range config.Projects {
+		p := p
 		p.Openshift = openshiftID
-		if err := i.AddProject(ctx, &p.AddProjectInput, &newProj); err != nil {
-			if errors.Is(err, ErrExist) {
-				// this project exists already
-				if !keepGoing {
-					return fmt.Errorf("project exists: %w", err)
-				}
-				if err = i.ProjectByName(ctx, p.Name, &newProj); err != nil {
-					return fmt.Errorf(
-						`couldn't get project "%s" by name: %w`, p.Name, err)
-				}
-				l.Printf(`project "%s" exists, using ID %d`, p.Name, newProj.ID)
-			} else {
-				if !keepGoing {
-					return fmt.Errorf("couldn't add Project: %w", err)
-				}
-				l.Printf("couldn't add Project: %v", err)
-				continue // next project
+		newProj := &schema.Project{}
+		project := g.add(nil, nil, func(ctx context.Context, l *log.Logger) error {
+			err := i.AddProject(ctx, &p.AddProjectInput, newProj)
+			if err == nil {
+				j.record(JournalEntry{Kind: "project", Name: p.Name}, nil)
+				return nil
 			}
-		}
+			if !errors.Is(err, ErrExist) {
+				j.record(JournalEntry{Kind: "project", Name: p.Name}, err)
+				return fmt.Errorf("couldn't add Project: %w", err)
+			}
+			// this project exists already
+			if !keepGoing {
+				return fmt.Errorf("project exists: %w", err)
+			}
+			if err = i.ProjectByName(ctx, p.Name, newProj); err != nil {
+				return abortError{fmt.Errorf(
+					`couldn't get project "%s" by name: %w`, p.Name, err)}
+			}
+			l.Printf(`project "%s" exists, using ID %d`, p.Name, newProj.ID)
+			return nil
+		})
 		// add project env-vars
 		for _, ev := range p.EnvVariables {
-			err := i.AddEnvVariable(ctx, &schema.EnvVariableInput{
-				EnvKeyValue: ev,
-				Type:        api.ProjectVar,
-				TypeID:      newProj.ID,
-			}, nil)
-			if err != nil {
-				if !keepGoing {
+			ev := ev
+			g.add(project, nil, func(ctx context.Context, _ *log.Logger) error {
+				err := i.AddEnvVariable(ctx, &schema.EnvVariableInput{
+					EnvKeyValue: ev,
+					Type:        api.ProjectVar,
+					TypeID:      newProj.ID,
+				}, nil)
+				j.record(JournalEntry{
+					Kind: "envVariable", Name: ev.Name, Project: p.Name}, err)
+				if err != nil {
 					return fmt.Errorf("couldn't add Project EnvVariable: %w", err)
 				}
-				l.Printf("couldn't add Project EnvVariable: %v", err)
-			}
+				return nil
+			})
 		}
 		// add project environments
 		for _, env := range p.Environments {
-			newEnv := schema.Environment{}
-			// inject project ID
-			env.Environment.AddEnvironmentInput.ProjectID = newProj.ID
-			err := i.AddOrUpdateEnvironment(
-				ctx, &env.Environment.AddEnvironmentInput, &newEnv)
-			if errors.Is(err, ErrExist) {
-				// this environment exists already
-				if !keepGoing {
-					return fmt.Errorf("environment exists: %w", err)
-				}
-				l.Printf(`environment "%s" (project "%s") exists, query by name for ID`,
-					env.Name, p.Name)
-				err = i.EnvironmentByName(ctx, env.Name, env.ProjectID, &newEnv)
-				if err != nil {
-					return fmt.Errorf("couldn't get environment by name: %w", err)
-				}
-			} else if err != nil {
-				if !keepGoing {
-					return fmt.Errorf("couldn't add Environment: %w", err)
-				}
-				l.Printf("couldn't add Environment: %v", err)
-				continue // next environment
-			}
-			// add environment env-vars
-			for _, ev := range env.EnvVariables {
-				err = i.AddEnvVariable(ctx, &schema.EnvVariableInput{
-					EnvKeyValue: ev,
-					Type:        api.EnvironmentVar,
-					TypeID:      newEnv.ID,
-				}, nil)
-				if err != nil {
+			env := env
+			newEnv := &schema.Environment{}
+			environment := g.add(project, nil,
+				func(ctx context.Context, l *log.Logger) error {
+					// inject project ID
+					env.Environment.AddEnvironmentInput.ProjectID = newProj.ID
+					// AddOrUpdateEnvironment updates existing environments
+					existed, err := environmentExists(ctx, i, j, env.Name, newProj.ID)
+					if err != nil {
+						return err
+					}
+					err = i.AddOrUpdateEnvironment(
+						ctx, &env.Environment.AddEnvironmentInput, newEnv)
+					if !errors.Is(err, ErrExist) {
+						j.recordCreated(JournalEntry{
+							Kind: "environment", Name: env.Name, Project: p.Name},
+							existed, err)
+						if err != nil {
+							return fmt.Errorf("couldn't add Environment: %w", err)
+						}
+						return nil
+					}
+					// this environment exists already
 					if !keepGoing {
-						return fmt.Errorf("couldn't add Environment EnvVariable: %w", err)
+						return fmt.Errorf("environment exists: %w", err)
 					}
-					l.Printf("couldn't add Environment EnvVariable: %v", err)
-				}
+					l.Printf(
+						`environment "%s" (project "%s") exists, query by name for ID`,
+						env.Name, p.Name)
+					err = i.EnvironmentByName(ctx, env.Name, env.ProjectID, newEnv)
+					if err != nil {
+						return abortError{
+							fmt.Errorf("couldn't get environment by name: %w", err)}
+					}
+					return nil
+				})
+			// add environment env-vars
+			for _, ev := range env.EnvVariables {
+				ev := ev
+				g.add(environment, nil,
+					func(ctx context.Context, _ *log.Logger) error {
+						err := i.AddEnvVariable(ctx, &schema.EnvVariableInput{
+							EnvKeyValue: ev,
+							Type:        api.EnvironmentVar,
+							TypeID:      newEnv.ID,
+						}, nil)
+						j.record(JournalEntry{Kind: "envVariable", Name: ev.Name,
+							Project: p.Name, Environment: env.Name}, err)
+						if err != nil {
+							return fmt.Errorf(
+								"couldn't add Environment EnvVariable: %w", err)
+						}
+						return nil
+					})
 			}
 		}
 		// add groups to project
 		if len(p.Groups) > 0 {
 			// convert group names to input type
 			groupsInput := []schema.GroupInput{}
+			var after []*importTask
 			for _, name := range p.Groups {
 				groupsInput = append(groupsInput, schema.GroupInput{Name: name})
+				after = append(after, groups[name])
 			}
-			err = i.AddGroupsToProject(ctx, &schema.ProjectGroupsInput{
-				Project: schema.ProjectInput{Name: p.Name},
-				Groups:  groupsInput}, nil)
-			if err != nil {
-				if !keepGoing {
+			g.add(project, after, func(ctx context.Context, _ *log.Logger) error {
+				attached, err := projectGroups(ctx, i, j, p.Name)
+				if err != nil {
+					return err
+				}
+				err = i.AddGroupsToProject(ctx, &schema.ProjectGroupsInput{
+					Project: schema.ProjectInput{Name: p.Name},
+					Groups:  groupsInput}, nil)
+				for _, name := range p.Groups {
+					j.recordCreated(JournalEntry{
+						Kind: "projectGroup", Project: p.Name, Group: name},
+						attached[name], err)
+				}
+				if err != nil {
 					return fmt.Errorf(
 						`couldn't add Groups to Project "%s": %w`, p.Name, err)
 				}
-				l.Printf(`couldn't add Groups to Project "%s": %v`, p.Name, err)
-			}
-		}
-		if len(p.BillingGroups) > 1 {
-			return fmt.Errorf(
-				`project can only have one billing group: %v`, p.BillingGroups)
+				return nil
+			})
 		}
 		// add project to billing group
 		for _, bgName := range p.BillingGroups {
-			err = i.AddProjectToBillingGroup(ctx, &schema.ProjectBillingGroupInput{
-				Group:   schema.GroupInput{Name: bgName},
-				Project: schema.ProjectInput{Name: p.Name},
-			}, nil)
-			if err != nil {
-				if !keepGoing {
-					return fmt.Errorf(
-						`couldn't add Project "%s" to Billing Group "%s": %w`, p.Name,
-						bgName, err)
-				}
-				l.Printf(`couldn't add Project "%s" to Billing Group "%s": %v`, p.Name,
-					bgName, err)
-			}
+			bgName := bgName
+			g.add(project, []*importTask{billingGroups[bgName]},
+				func(ctx context.Context, _ *log.Logger) error {
+					err := i.AddProjectToBillingGroup(ctx,
+						&schema.ProjectBillingGroupInput{
+							Group:   schema.GroupInput{Name: bgName},
+							Project: schema.ProjectInput{Name: p.Name},
+						}, nil)
+					j.record(JournalEntry{Kind: "projectBillingGroup",
+						Project: p.Name, Group: bgName}, err)
+					if err != nil {
+						return fmt.Errorf(
+							`couldn't add Project "%s" to Billing Group "%s": %w`,
+							p.Name, bgName, err)
+					}
+					return nil
+				})
 		}
 		// add project users
 		for _, u := range p.Users {
-			err := i.AddUserToGroup(ctx, &schema.UserGroupRoleInput{
-				UserEmail: u.Email,
-				GroupName: fmt.Sprintf(`project-%s`, p.Name),
-				GroupRole: u.Role,
-			}, nil)
-			if err != nil {
-				if !keepGoing {
-					return fmt.Errorf("couldn't add user to project group: %w", err)
-				}
-				l.Printf("couldn't add user to project group: %v", err)
-			}
-		}
-		// add project notifications
-		if p.Notifications != nil {
-			for _, n := range p.Notifications.Slack {
-				err := i.AddNotificationToProject(ctx,
-					&schema.AddNotificationToProjectInput{
-						Project:          p.Name,
-						NotificationType: api.SlackNotification,
-						NotificationName: n,
-					}, nil)
-				if err != nil {
-					if !keepGoing {
-						return fmt.Errorf(
-							"couldn't add Slack Notification to project: %w", err)
+			u := u
+			g.add(project, []*importTask{users[u.Email]},
+				func(ctx context.Context, _ *log.Logger) error {
+					groupName := fmt.Sprintf(`project-%s`, p.Name)
+					existed, err := isGroupMember(ctx, i, j, groupName, u.Email)
+					if err != nil {
+						return err
 					}
-					l.Printf("couldn't add Slack Notification to project: %v", err)
-				}
-			}
-			for _, n := range p.Notifications.RocketChat {
-				err := i.AddNotificationToProject(ctx,
-					&schema.AddNotificationToProjectInput{
-						Project:          p.Name,
-						NotificationType: api.RocketChatNotification,
-						NotificationName: n,
+					err = i.AddUserToGroup(ctx, &schema.UserGroupRoleInput{
+						UserEmail: u.Email,
+						GroupName: groupName,
+						GroupRole: u.Role,
 					}, nil)
-				if err != nil {
-					if !keepGoing {
+					j.recordCreated(JournalEntry{
+						Kind: "projectUser", Project: p.Name, User: u.Email},
+						existed, err)
+					if err != nil {
 						return fmt.Errorf(
-							"couldn't add RocketChat Notification to project: %w", err)
+							"couldn't add user to project group: %w", err)
 					}
-					l.Printf("couldn't add RocketChat Notification to project: %v", err)
-				}
-			}
-			for _, n := range p.Notifications.Email {
-				err := i.AddNotificationToProject(ctx,
-					&schema.AddNotificationToProjectInput{
-						Project:          p.Name,
-						NotificationType: api.EmailNotification,
-						NotificationName: n,
-					}, nil)
-				if err != nil {
-					if !keepGoing {
-						return fmt.Errorf(
-							"couldn't add Email Notification to project: %w", err)
-					}
-					l.Printf("couldn't add Email Notification to project: %v", err)
+					return nil
+				})
+		}
+		// add project notifications
+		if p.Notifications != nil {
+			for _, pn := range []struct {
+				notificationType api.NotificationType
+				label            string
+				names            []string
+			}{
+				{api.SlackNotification, "Slack", p.Notifications.Slack},
+				{api.RocketChatNotification, "RocketChat",
+					p.Notifications.RocketChat},
+				{api.EmailNotification, "Email", p.Notifications.Email},
+				{api.MicrosoftTeamsNotification, "MicrosoftTeams",
+					p.Notifications.MicrosoftTeams},
+			} {
+				for _, n := range pn.names {
+					pn, n := pn, n
+					g.add(project,
+						[]*importTask{notifications[pn.notificationType][n]},
+						func(ctx context.Context, _ *log.Logger) error {
+							err := i.AddNotificationToProject(ctx,
+								&schema.AddNotificationToProjectInput{
+									Project:          p.Name,
+									NotificationType: pn.notificationType,
+									NotificationName: n,
+								}, nil)
+							j.record(JournalEntry{Kind: "projectNotification", Name: n,
+								Project: p.Name, Type: pn.notificationType}, err)
+							if err != nil {
+								return fmt.Errorf(
+									"couldn't add %s Notification to project: %w",
+									pn.label, err)
+							}
+							return nil
+						})
 				}
 			}
-			for _, n := range p.Notifications.MicrosoftTeams {
-				err := i.AddNotificationToProject(ctx,
-					&schema.AddNotificationToProjectInput{
-						Project:          p.Name,
-						NotificationType: api.MicrosoftTeamsNotification,
-						NotificationName: n,
-					}, nil)
-				if err != nil {
-					if !keepGoing {
-						return fmt.Errorf(
-							"couldn't add MicrosoftTeams Notification to project: %w", err)
-					}
-					l.Printf("couldn't add MicrosoftTeams Notification to project: %v", err)
-				}
+		}
+	}
+
+	return g.run(ctx, parallel, keepGoing, os.Stderr)
+}
+
+// environmentExists returns true if the environment exists already. It only
+// queries the API if j is not nil, as the answer is only needed for the
+// journal.
+func environmentExists(ctx context.Context, i Importer, j *Journal,
+	name string, projectID uint) (bool, error) {
+	if j == nil || projectID == 0 {
+		return false, nil
+	}
+	environment := schema.Environment{}
+	err := i.EnvironmentByName(ctx, name, projectID, &environment)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf(`couldn't check for environment "%s": %w`,
+			name, err)
+	}
+	return environment.ID != 0, nil
+}
+
+// isGroupMember returns true if the user is a member of the group already. It
+// only queries the API if j is not nil.
+func isGroupMember(ctx context.Context, i Importer, j *Journal,
+	groupName, email string) (bool, error) {
+	if j == nil {
+		return false, nil
+	}
+	groups := schema.Groups{}
+	if err := i.GroupByName(ctx, groupName, &groups); err != nil {
+		return false, fmt.Errorf(`couldn't check members of group "%s": %w`,
+			groupName, err)
+	}
+	for _, group := range groups.Groups {
+		if group.Name != groupName {
+			continue
+		}
+		for _, m := range group.Members {
+			if m.User.Email == email {
+				return true, nil
 			}
 		}
 	}
+	return false, nil
+}
 
-	return nil
+// projectGroups returns the names of the groups which are attached to the
+// project already. It only queries the API if j is not nil.
+func projectGroups(ctx context.Context, i Importer, j *Journal,
+	projectName string) (map[string]bool, error) {
+	attached := map[string]bool{}
+	if j == nil {
+		return attached, nil
+	}
+	project := schema.Project{}
+	if err := i.ProjectByName(ctx, projectName, &project); err != nil {
+		return nil, fmt.Errorf(`couldn't check groups of project "%s": %w`,
+			projectName, err)
+	}
+	if project.Groups != nil {
+		for _, group := range project.Groups.Groups {
+			attached[group.Name] = true
+		}
+	}
+	return attached, nil
+}
+
+// logFailures logs a summary of the failures recorded in the Journal.
+func logFailures(l *log.Logger, j *Journal) {
+	failures := j.Failures()
+	if len(failures) == 0 {
+		return
+	}
+	l.Printf("%d failures:", len(failures))
+	for _, e := range failures {
+		l.Printf("  %s: %s", e, e.Error)
+	}
 }
@@ -4,6 +4,7 @@ package lagoon_test
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"testing"
 
@@ -195,89 +196,93 @@ func TestImport(t *testing.T) {
 		}},
 	}
 	for name, tc := range testCases {
-		t.Run(name, func(tt *testing.T) {
-			ctx := context.Background()
-			// set up the mock importer
-			ctrl := gomock.NewController(tt)
-			defer ctrl.Finish()
-			importer := mock.NewMockImporter(ctrl)
-			// use the provided importCalls to set the expectations
-			for i := range tc.expect.AddGroupInputs {
-				importer.EXPECT().AddGroup(ctx, &tc.expect.AddGroupInputs[i], nil)
-			}
-			for i := range tc.expect.AddUserInputs {
-				importer.EXPECT().AddUser(ctx, &tc.expect.AddUserInputs[i], nil)
-			}
-			for i := range tc.expect.AddSSHKeyInputs {
-				importer.EXPECT().AddSSHKey(ctx, &tc.expect.AddSSHKeyInputs[i], nil)
-			}
-			for i := range tc.expect.UserGroupRoleInputs {
-				importer.EXPECT().AddUserToGroup(
-					ctx, &tc.expect.UserGroupRoleInputs[i], nil)
-			}
-			for i := range tc.expect.AddNotificationSlackInputs {
-				importer.EXPECT().AddNotificationSlack(
-					ctx, &tc.expect.AddNotificationSlackInputs[i], nil)
-			}
-			for i := range tc.expect.AddNotificationRocketChatInputs {
-				importer.EXPECT().AddNotificationRocketChat(
-					ctx, &tc.expect.AddNotificationRocketChatInputs[i], nil)
-			}
-			for i := range tc.expect.AddNotificationEmailInputs {
-				importer.EXPECT().AddNotificationEmail(
-					ctx, &tc.expect.AddNotificationEmailInputs[i], nil)
-			}
-			for i := range tc.expect.AddNotificationMicrosoftTeamsInputs {
-				importer.EXPECT().AddNotificationMicrosoftTeams(
-					ctx, &tc.expect.AddNotificationMicrosoftTeamsInputs[i], nil)
-			}
-			for i := range tc.expect.AddProjectInputs {
-				importer.EXPECT().AddProject(
-					ctx, &tc.expect.AddProjectInputs[i], &schema.Project{}).Do(
-					func(_ context.Context,
-						_ *schema.AddProjectInput, p *schema.Project) {
-						// set the ProjectID as the env variables calls require it
-						p.ID = tc.expect.NewProjectID
-					})
-			}
-			for i := range tc.expect.EnvVariableInputs {
-				importer.EXPECT().AddEnvVariable(
-					ctx, &tc.expect.EnvVariableInputs[i], nil)
-			}
-			for i := range tc.expect.AddEnvironmentInputs {
-				importer.EXPECT().AddOrUpdateEnvironment(
-					ctx, &tc.expect.AddEnvironmentInputs[i], &schema.Environment{}).Do(
-					func(_ context.Context,
-						_ *schema.AddEnvironmentInput, e *schema.Environment) {
-						// set the EnvironmentID as the env variables calls require it
-						e.ID = tc.expect.NewEnvironmentID
-					})
-			}
-			for i := range tc.expect.ProjectGroupsInputs {
-				importer.EXPECT().AddGroupsToProject(
-					ctx, &tc.expect.ProjectGroupsInputs[i], nil)
-			}
-			for i := range tc.expect.AddNotificationToProjectInputs {
-				importer.EXPECT().AddNotificationToProject(
-					ctx, &tc.expect.AddNotificationToProjectInputs[i], nil)
-			}
-			for i := range tc.expect.AddBillingGroupInputs {
-				importer.EXPECT().AddBillingGroup(ctx,
-					&tc.expect.AddBillingGroupInputs[i], nil)
-			}
-			for i := range tc.expect.ProjectBillingGroupInputs {
-				importer.EXPECT().AddProjectToBillingGroup(ctx,
-					&tc.expect.ProjectBillingGroupInputs[i], nil)
-			}
-			// open the test yaml
-			file, err := os.Open(tc.input)
-			if err != nil {
-				tt.Fatal(err)
-			}
-			// run the import
-			if err := lagoon.Import(ctx, importer, file, true, 2); err != nil {
-				tt.Fatal(err)
-			}
-		})
+		for _, parallel := range []int{1, 8} {
+			parallel := parallel
+			t.Run(fmt.Sprintf("%s/parallel%d", name, parallel), func(tt *testing.T) {
+				ctx := context.Background()
+				// set up the mock importer
+				ctrl := gomock.NewController(tt)
+				defer ctrl.Finish()
+				importer := mock.NewMockImporter(ctrl)
+				// use the provided importCalls to set the expectations
+				for i := range tc.expect.AddGroupInputs {
+					importer.EXPECT().AddGroup(ctx, &tc.expect.AddGroupInputs[i], nil)
+				}
+				for i := range tc.expect.AddUserInputs {
+					importer.EXPECT().AddUser(ctx, &tc.expect.AddUserInputs[i], nil)
+				}
+				for i := range tc.expect.AddSSHKeyInputs {
+					importer.EXPECT().AddSSHKey(ctx, &tc.expect.AddSSHKeyInputs[i], nil)
+				}
+				for i := range tc.expect.UserGroupRoleInputs {
+					importer.EXPECT().AddUserToGroup(
+						ctx, &tc.expect.UserGroupRoleInputs[i], nil)
+				}
+				for i := range tc.expect.AddNotificationSlackInputs {
+					importer.EXPECT().AddNotificationSlack(
+						ctx, &tc.expect.AddNotificationSlackInputs[i], nil)
+				}
+				for i := range tc.expect.AddNotificationRocketChatInputs {
+					importer.EXPECT().AddNotificationRocketChat(
+						ctx, &tc.expect.AddNotificationRocketChatInputs[i], nil)
+				}
+				for i := range tc.expect.AddNotificationEmailInputs {
+					importer.EXPECT().AddNotificationEmail(
+						ctx, &tc.expect.AddNotificationEmailInputs[i], nil)
+				}
+				for i := range tc.expect.AddNotificationMicrosoftTeamsInputs {
+					importer.EXPECT().AddNotificationMicrosoftTeams(
+						ctx, &tc.expect.AddNotificationMicrosoftTeamsInputs[i], nil)
+				}
+				for i := range tc.expect.AddProjectInputs {
+					importer.EXPECT().AddProject(
+						ctx, &tc.expect.AddProjectInputs[i], &schema.Project{}).Do(
+						func(_ context.Context,
+							_ *schema.AddProjectInput, p *schema.Project) {
+							// set the ProjectID as the env variables calls require it
+							p.ID = tc.expect.NewProjectID
+						})
+				}
+				for i := range tc.expect.EnvVariableInputs {
+					importer.EXPECT().AddEnvVariable(
+						ctx, &tc.expect.EnvVariableInputs[i], nil)
+				}
+				for i := range tc.expect.AddEnvironmentInputs {
+					importer.EXPECT().AddOrUpdateEnvironment(
+						ctx, &tc.expect.AddEnvironmentInputs[i], &schema.Environment{}).Do(
+						func(_ context.Context,
+							_ *schema.AddEnvironmentInput, e *schema.Environment) {
+							// set the EnvironmentID as the env variables calls require it
+							e.ID = tc.expect.NewEnvironmentID
+						})
+				}
+				for i := range tc.expect.ProjectGroupsInputs {
+					importer.EXPECT().AddGroupsToProject(
+						ctx, &tc.expect.ProjectGroupsInputs[i], nil)
+				}
+				for i := range tc.expect.AddNotificationToProjectInputs {
+					importer.EXPECT().AddNotificationToProject(
+						ctx, &tc.expect.AddNotificationToProjectInputs[i], nil)
+				}
+				for i := range tc.expect.AddBillingGroupInputs {
+					importer.EXPECT().AddBillingGroup(ctx,
+						&tc.expect.AddBillingGroupInputs[i], nil)
+				}
+				for i := range tc.expect.ProjectBillingGroupInputs {
+					importer.EXPECT().AddProjectToBillingGroup(ctx,
+						&tc.expect.ProjectBillingGroupInputs[i], nil)
+				}
+				// open the test yaml
+				file, err := os.Open(tc.input)
+				if err != nil {
+					tt.Fatal(err)
+				}
+				// run the import
+				if err := lagoon.Import(
+					ctx, importer, file, true, 2, parallel, nil); err != nil {
+					tt.Fatal(err)
+				}
+			})
+		}
 	}
 }
@@ -0,0 +1,289 @@
+package lagoon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+)
+
+// JournalEntry records the outcome of a single mutation made during import.
+// Kind is the type of object created, and the other fields identify it.
+type JournalEntry struct {
+	Kind        string               `json:"kind"`
+	Name        string               `json:"name,omitempty"`
+	Project     string               `json:"project,omitempty"`
+	Environment string               `json:"environment,omitempty"`
+	Group       string               `json:"group,omitempty"`
+	User        string               `json:"user,omitempty"`
+	Type        api.NotificationType `json:"type,omitempty"`
+	// Error is set if the mutation failed.
+	Error string `json:"error,omitempty"`
+}
+
+// String implements fmt.Stringer.
+func (e JournalEntry) String() string {
+	var attrs []string
+	for _, a := range []struct{ key, value string }{
+		{"name", e.Name},
+		{"project", e.Project},
+		{"environment", e.Environment},
+		{"group", e.Group},
+		{"user", e.User},
+		{"type", string(e.Type)},
+	} {
+		if a.value != "" {
+			attrs = append(attrs, fmt.Sprintf(`%s "%s"`, a.key, a.value))
+		}
+	}
+	return fmt.Sprintf("%s (%s)", e.Kind, strings.Join(attrs, ", "))
+}
+
+// Journal records the outcome of each mutation made during import, so that
+// created objects can be rolled back. Entries are written to the underlying
+// writer as JSON lines as they are recorded, so the journal is complete even
+// if the import is interrupted.
+type Journal struct {
+	mu      sync.Mutex
+	enc     *json.Encoder
+	entries []JournalEntry
+	err     error
+}
+
+// NewJournal creates a Journal which writes to w.
+func NewJournal(w io.Writer) *Journal {
+	return &Journal{enc: json.NewEncoder(w)}
+}
+
+// record adds an entry to the Journal. If err is not nil the entry is
+// recorded as a failure. record is a no-op on a nil Journal.
+func (j *Journal) record(e JournalEntry, err error) {
+	if j == nil {
+		return
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.entries = append(j.entries, e)
+	if werr := j.enc.Encode(e); werr != nil && j.err == nil {
+		j.err = werr
+	}
+}
+
+// recordCreated records an entry like record, unless the mutation succeeded on
+// an object which existed before it, so that the object isn't rolled back.
+func (j *Journal) recordCreated(e JournalEntry, existed bool, err error) {
+	if existed && err == nil {
+		return
+	}
+	j.record(e, err)
+}
+
+// Failures returns the failed entries in the Journal.
+func (j *Journal) Failures() []JournalEntry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	var failures []JournalEntry
+	for _, e := range j.entries {
+		if e.Error != "" {
+			failures = append(failures, e)
+		}
+	}
+	return failures
+}
+
+// Err returns the first error encountered writing the Journal, if any.
+func (j *Journal) Err() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.err
+}
+
+// ReadJournal reads the entries written by a Journal from r.
+func ReadJournal(r io.Reader) ([]JournalEntry, error) {
+	var entries []JournalEntry
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1024*1024)
+	for line := 1; s.Scan(); line++ {
+		if strings.TrimSpace(s.Text()) == "" {
+			continue
+		}
+		e := JournalEntry{}
+		if err := json.Unmarshal(s.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("couldn't parse journal line %d: %w", line, err)
+		}
+		entries = append(entries, e)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("couldn't read journal: %w", err)
+	}
+	return entries, nil
+}
+
+// Rollbacker interface contains methods for deleting objects created during
+// import.
+type Rollbacker interface {
+	ProjectByName(context.Context, string, *schema.Project) error
+	DeleteBillingGroup(context.Context, *schema.DeleteGroupInput, *string) error
+	DeleteGroup(context.Context, *schema.DeleteGroupInput, *string) error
+	DeleteUser(context.Context, *schema.DeleteUserInput, *string) error
+	DeleteSSHKey(context.Context, *schema.DeleteSSHKeyInput, *string) error
+	RemoveUserFromGroup(
+		context.Context, *schema.UserGroupInput, *schema.Group) error
+	DeleteNotificationSlack(
+		context.Context, *schema.DeleteNotificationInput, *string) error
+	DeleteNotificationRocketChat(
+		context.Context, *schema.DeleteNotificationInput, *string) error
+	DeleteNotificationEmail(
+		context.Context, *schema.DeleteNotificationInput, *string) error
+	DeleteNotificationMicrosoftTeams(
+		context.Context, *schema.DeleteNotificationInput, *string) error
+	DeleteProject(context.Context, *schema.DeleteProjectInput, *string) error
+	DeleteEnvVariable(
+		context.Context, *schema.DeleteEnvVariableInput, *string) error
+	DeleteEnvironment(
+		context.Context, *schema.DeleteEnvironmentInput, *string) error
+	RemoveGroupsFromProject(
+		context.Context, *schema.ProjectGroupsInput, *schema.Project) error
+	RemoveProjectFromBillingGroup(context.Context,
+		*schema.ProjectBillingGroupInput, *schema.Project) error
+	RemoveNotificationFromProject(context.Context,
+		*schema.RemoveNotificationFromProjectInput, *schema.Project) error
+}
+
+// Rollback deletes the objects recorded as created in the journal entries, in
+// reverse order. Failed entries are ignored.
+func Rollback(ctx context.Context, r Rollbacker, entries []JournalEntry,
+	keepGoing bool) error {
+	l := log.New(os.Stderr, "rollback: ", 0)
+	var failed int
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		if e.Error != "" {
+			continue // nothing was created
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := rollbackEntry(ctx, r, e); err != nil {
+			if !keepGoing {
+				return fmt.Errorf("couldn't roll back %s: %w", e, err)
+			}
+			l.Printf("couldn't roll back %s: %v", e, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d objects couldn't be rolled back", failed)
+	}
+	return nil
+}
+
+// rollbackEntry deletes the object created by a single journal entry.
+func rollbackEntry(ctx context.Context, r Rollbacker, e JournalEntry) error {
+	switch e.Kind {
+	case "billingGroup":
+		return r.DeleteBillingGroup(ctx, &schema.DeleteGroupInput{
+			Group: schema.GroupInput{Name: e.Name}}, nil)
+	case "group":
+		return r.DeleteGroup(ctx, &schema.DeleteGroupInput{
+			Group: schema.GroupInput{Name: e.Name}}, nil)
+	case "user":
+		return r.DeleteUser(ctx, &schema.DeleteUserInput{
+			User: schema.UserInput{Email: e.Name}}, nil)
+	case "sshKey":
+		return r.DeleteSSHKey(ctx, &schema.DeleteSSHKeyInput{Name: e.Name}, nil)
+	case "groupMember":
+		return r.RemoveUserFromGroup(ctx, &schema.UserGroupInput{
+			UserEmail: e.User,
+			GroupName: e.Group,
+		}, nil)
+	case "notificationSlack":
+		return r.DeleteNotificationSlack(ctx,
+			&schema.DeleteNotificationInput{Name: e.Name}, nil)
+	case "notificationRocketChat":
+		return r.DeleteNotificationRocketChat(ctx,
+			&schema.DeleteNotificationInput{Name: e.Name}, nil)
+	case "notificationEmail":
+		return r.DeleteNotificationEmail(ctx,
+			&schema.DeleteNotificationInput{Name: e.Name}, nil)
+	case "notificationMicrosoftTeams":
+		return r.DeleteNotificationMicrosoftTeams(ctx,
+			&schema.DeleteNotificationInput{Name: e.Name}, nil)
+	case "project":
+		return r.DeleteProject(ctx,
+			&schema.DeleteProjectInput{Project: e.Name}, nil)
+	case "envVariable":
+		// envVariables are deleted by ID, which isn't known until now
+		id, err := envVariableID(ctx, r, e)
+		if err != nil {
+			return err
+		}
+		return r.DeleteEnvVariable(ctx, &schema.DeleteEnvVariableInput{ID: id}, nil)
+	case "environment":
+		return r.DeleteEnvironment(ctx, &schema.DeleteEnvironmentInput{
+			Name:    e.Name,
+			Project: e.Project,
+			Execute: true,
+		}, nil)
+	case "projectGroup":
+		return r.RemoveGroupsFromProject(ctx, &schema.ProjectGroupsInput{
+			Project: schema.ProjectInput{Name: e.Project},
+			Groups:  []schema.GroupInput{{Name: e.Group}},
+		}, nil)
+	case "projectBillingGroup":
+		return r.RemoveProjectFromBillingGroup(ctx,
+			&schema.ProjectBillingGroupInput{
+				Group:   schema.GroupInput{Name: e.Group},
+				Project: schema.ProjectInput{Name: e.Project},
+			}, nil)
+	case "projectUser":
+		return r.RemoveUserFromGroup(ctx, &schema.UserGroupInput{
+			UserEmail: e.User,
+			GroupName: fmt.Sprintf("project-%s", e.Project),
+		}, nil)
+	case "projectNotification":
+		return r.RemoveNotificationFromProject(ctx,
+			&schema.RemoveNotificationFromProjectInput{
+				Project:          e.Project,
+				NotificationType: e.Type,
+				NotificationName: e.Name,
+			}, nil)
+	default:
+		return fmt.Errorf("unknown kind: %s", e.Kind)
+	}
+}
+
+// envVariableID looks up the ID of the envVariable in a journal entry.
+func envVariableID(ctx context.Context, r Rollbacker,
+	e JournalEntry) (uint, error) {
+	project := schema.Project{}
+	if err := r.ProjectByName(ctx, e.Project, &project); err != nil {
+		return 0, fmt.Errorf(`couldn't get project "%s": %w`, e.Project, err)
+	}
+	evs := project.EnvVariables
+	if e.Environment != "" {
+		evs = nil
+		for _, env := range project.Environments {
+			if env.Name == e.Environment {
+				evs = env.EnvVariables
+			}
+		}
+	}
+	for _, ev := range evs {
+		if ev.Name == e.Name {
+			return ev.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("envVariable %w", ErrNotFound)
+}
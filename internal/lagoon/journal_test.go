@@ -0,0 +1,214 @@
+//go:generate mockgen -source=journal.go -destination=../mock/mock_rollbacker.go -package=mock
+
+package lagoon_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/lagoon/client"
+	"github.com/amazeeio/lagoon-cli/internal/mock"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+	"github.com/golang/mock/gomock"
+)
+
+func TestJournal(t *testing.T) {
+	var testCases = map[string]struct {
+		input  string
+		expect string
+	}{
+		"dryRun": {
+			input:  "client/testdata/dryRun.import.yaml",
+			expect: "testdata/dryRun.golden.journal",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			c, _ := client.NewDryRun("1.0.0")
+			file, err := os.Open(tc.input)
+			if err != nil {
+				tt.Fatalf("couldn't open file: %v", err)
+			}
+			defer file.Close()
+			var buf bytes.Buffer
+			journal := lagoon.NewJournal(&buf)
+			err = lagoon.Import(context.Background(), c, file, false, 1, 1, journal)
+			if err != nil {
+				tt.Fatalf("couldn't import: %v", err)
+			}
+			if err = journal.Err(); err != nil {
+				tt.Fatalf("couldn't write journal: %v", err)
+			}
+			result := buf.Bytes()
+
+			if *update {
+				tt.Logf("update golden file: %s", tc.expect)
+				if err = ioutil.WriteFile(tc.expect, result, 0644); err != nil {
+					tt.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+
+			expected, err := ioutil.ReadFile(tc.expect)
+			if err != nil {
+				tt.Fatalf("failed reading golden file: %v", err)
+			}
+			if !bytes.Equal(result, expected) {
+				tt.Logf("result:\n%s\nexpected:\n%s", result, expected)
+				tt.Errorf("result does not match expected")
+			}
+			// the journal can be read back
+			entries, err := lagoon.ReadJournal(bytes.NewReader(result))
+			if err != nil {
+				tt.Fatalf("couldn't read journal: %v", err)
+			}
+			if len(journal.Failures()) != 0 {
+				tt.Fatalf("unexpected failures: %v", journal.Failures())
+			}
+			if len(entries) == 0 {
+				tt.Fatalf("expected journal entries, got none")
+			}
+		})
+	}
+}
+
+func TestRollback(t *testing.T) {
+	journal := `{"kind":"group","name":"abc"}
+{"kind":"project","name":"bananas"}
+{"kind":"envVariable","name":"FOO","project":"bananas","environment":"master"}
+{"kind":"projectGroup","project":"bananas","group":"abc"}
+{"kind":"projectBillingGroup","project":"bananas","group":"xyz","error":"failed"}
+{"kind":"projectNotification","name":"slack","project":"bananas","type":"SLACK"}
+`
+	entries, err := lagoon.ReadJournal(bytes.NewBufferString(journal))
+	if err != nil {
+		t.Fatalf("couldn't read journal: %v", err)
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	r := mock.NewMockRollbacker(ctrl)
+	gomock.InOrder(
+		r.EXPECT().RemoveNotificationFromProject(ctx,
+			&schema.RemoveNotificationFromProjectInput{
+				Project:          "bananas",
+				NotificationType: api.SlackNotification,
+				NotificationName: "slack",
+			}, nil),
+		r.EXPECT().RemoveGroupsFromProject(ctx, &schema.ProjectGroupsInput{
+			Project: schema.ProjectInput{Name: "bananas"},
+			Groups:  []schema.GroupInput{{Name: "abc"}},
+		}, nil),
+		r.EXPECT().ProjectByName(ctx, "bananas", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, project *schema.Project) error {
+				env := schema.EnvironmentConfig{}
+				env.Name = "master"
+				env.EnvVariables = []schema.EnvKeyValue{{ID: 7, Name: "FOO"}}
+				project.Environments = []schema.EnvironmentConfig{env}
+				return nil
+			}),
+		r.EXPECT().DeleteEnvVariable(ctx,
+			&schema.DeleteEnvVariableInput{ID: 7}, nil),
+		r.EXPECT().DeleteProject(ctx,
+			&schema.DeleteProjectInput{Project: "bananas"}, nil),
+		r.EXPECT().DeleteGroup(ctx, &schema.DeleteGroupInput{
+			Group: schema.GroupInput{Name: "abc"},
+		}, nil),
+	)
+	if err = lagoon.Rollback(ctx, r, entries, false); err != nil {
+		t.Fatalf("couldn't roll back: %v", err)
+	}
+}
+
+func TestRollbackPreExisting(t *testing.T) {
+	config := `groups:
+- name: abc
+  users:
+  - email: foo@example.com
+    role: OWNER
+projects:
+- name: bananas
+  gitUrl: git@github.amazee.io:foo-bar/bananas-au.git
+  productionEnvironment: master
+  environments:
+  - name: master
+    openshiftProjectName: bananas-master
+  groups:
+  - abc
+  users:
+  - email: foo@example.com
+    role: MAINTAINER
+users:
+- email: foo@example.com
+`
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// every object in the config exists already
+	importer := mock.NewMockImporter(ctrl)
+	importer.EXPECT().AddGroup(ctx, gomock.Any(), nil).Return(lagoon.ErrExist)
+	importer.EXPECT().AddUser(ctx, gomock.Any(), nil).Return(lagoon.ErrExist)
+	importer.EXPECT().AddProject(ctx, gomock.Any(), gomock.Any()).
+		Return(lagoon.ErrExist)
+	importer.EXPECT().ProjectByName(ctx, "bananas", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, project *schema.Project) error {
+			project.ID = 7
+			project.Groups = &schema.Groups{
+				Groups: []schema.Group{{AddGroupInput: schema.AddGroupInput{
+					Name: "abc"}}},
+			}
+			return nil
+		}).Times(2)
+	importer.EXPECT().EnvironmentByName(ctx, "master", uint(7), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ uint,
+			env *schema.Environment) error {
+			env.ID = 9
+			return nil
+		})
+	importer.EXPECT().AddOrUpdateEnvironment(ctx, gomock.Any(), gomock.Any())
+	importer.EXPECT().GroupByName(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, name string, groups *schema.Groups) error {
+			return json.Unmarshal([]byte(`[{"__typename":"Group","name":"`+name+
+				`","members":[{"user":{"email":"foo@example.com"}}]}]`), groups)
+		}).Times(2)
+	importer.EXPECT().AddUserToGroup(ctx, gomock.Any(), nil).Times(2)
+	importer.EXPECT().AddGroupsToProject(ctx, gomock.Any(), nil)
+
+	var buf bytes.Buffer
+	journal := lagoon.NewJournal(&buf)
+	_ = lagoon.Import(ctx, importer, bytes.NewBufferString(config), true, 1, 1,
+		journal)
+	entries, err := lagoon.ReadJournal(&buf)
+	if err != nil {
+		t.Fatalf("couldn't read journal: %v", err)
+	}
+	for _, e := range entries {
+		if e.Error == "" {
+			t.Errorf("pre-existing object journaled as created: %v", e)
+		}
+	}
+	// no calls are expected on the rollbacker, so nothing is deleted
+	r := mock.NewMockRollbacker(ctrl)
+	if err = lagoon.Rollback(ctx, r, entries, false); err != nil {
+		t.Fatalf("couldn't roll back: %v", err)
+	}
+}
+
+func TestJournalEntryString(t *testing.T) {
+	e := lagoon.JournalEntry{
+		Kind:        "envVariable",
+		Name:        "FOO",
+		Project:     "bananas",
+		Environment: "master",
+	}
+	expect := `envVariable (name "FOO", project "bananas", environment "master")`
+	if !reflect.DeepEqual(e.String(), expect) {
+		t.Fatalf("expected %s, got %s", expect, e.String())
+	}
+}
@@ -0,0 +1,81 @@
+package lagoon
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+// ResolveSecret returns the value of a secret reference in a config file.
+// Files are read relative to the current directory, and commands are run with
+// sh -c. Trailing newlines are removed from file contents and command output,
+// as in shell command substitution.
+func ResolveSecret(ref schema.SecretRef) (string, error) {
+	switch {
+	case ref.FromEnv != "":
+		value, ok := os.LookupEnv(ref.FromEnv)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", ref.FromEnv)
+		}
+		return value, nil
+	case ref.FromFile != "":
+		data, err := ioutil.ReadFile(ref.FromFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(data), "\n"), nil
+	case ref.FromCommand != "":
+		cmd := exec.Command("sh", "-c", ref.FromCommand)
+		// allow the command to prompt, e.g. for a passphrase
+		cmd.Stdin = os.Stdin
+		cmd.Stderr = os.Stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(out), "\n"), nil
+	default:
+		return "", fmt.Errorf("empty secret reference")
+	}
+}
+
+// RedactConfig replaces the secret values in the given YAML config with
+// fromEnv secret references, and returns the redacted config along with the
+// secret values by environment variable name. See schema.Config.RedactSecrets.
+func RedactConfig(data []byte) ([]byte, map[string]string, error) {
+	config := schema.Config{}
+	if err := schema.UnmarshalConfigYAML(data, &config); err != nil {
+		return nil, nil, fmt.Errorf("couldn't unmarshal config: %w", err)
+	}
+	secrets := config.RedactSecrets()
+	data, err := schema.MarshalConfigYAML(&config, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, secrets, nil
+}
+
+// WriteSecrets writes the given secrets to w as export NAME='value' lines,
+// sorted by name, which can be sourced by a POSIX shell to set the environment
+// variables referred to by a redacted config.
+func WriteSecrets(w io.Writer, secrets map[string]string) error {
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&buf, "export %s='%s'\n", name,
+			strings.Replace(secrets[name], "'", `'\''`, -1))
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
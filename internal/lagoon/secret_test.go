@@ -0,0 +1,173 @@
+package lagoon_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/lagoon/client"
+	"github.com/amazeeio/lagoon-cli/internal/mock"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/golang/mock/gomock"
+)
+
+func TestResolveSecret(t *testing.T) {
+	if err := os.Setenv("LAGOON_SECRET_TEST", "env secret"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("LAGOON_SECRET_TEST")
+	var testCases = map[string]struct {
+		ref       schema.SecretRef
+		expect    string
+		expectErr string
+	}{
+		"fromEnv": {
+			ref:    schema.SecretRef{FromEnv: "LAGOON_SECRET_TEST"},
+			expect: "env secret",
+		},
+		"fromEnv unset": {
+			ref:       schema.SecretRef{FromEnv: "LAGOON_SECRET_TEST_UNSET"},
+			expectErr: "environment variable LAGOON_SECRET_TEST_UNSET is not set",
+		},
+		"fromFile": {
+			ref:    schema.SecretRef{FromFile: "testdata/secret.txt"},
+			expect: "file secret",
+		},
+		"fromFile missing": {
+			ref:       schema.SecretRef{FromFile: "testdata/missing.txt"},
+			expectErr: "no such file or directory",
+		},
+		"fromCommand": {
+			ref:    schema.SecretRef{FromCommand: "echo command secret"},
+			expect: "command secret",
+		},
+		"fromCommand failed": {
+			ref:       schema.SecretRef{FromCommand: "exit 3"},
+			expectErr: "exit status 3",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			result, err := lagoon.ResolveSecret(tc.ref)
+			if tc.expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectErr) {
+					tt.Fatalf("expected error containing %q, got %v", tc.expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if result != tc.expect {
+				tt.Fatalf("expected %q, got %q", tc.expect, result)
+			}
+		})
+	}
+}
+
+func TestWriteSecrets(t *testing.T) {
+	var buf bytes.Buffer
+	err := lagoon.WriteSecrets(&buf, map[string]string{
+		"LAGOON_SECRET_B": "it's a secret",
+		"LAGOON_SECRET_A": "line 1\nline 2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "export LAGOON_SECRET_A='line 1\nline 2'\n" +
+		"export LAGOON_SECRET_B='it'\\''s a secret'\n"
+	if buf.String() != expect {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expect, buf.String())
+	}
+}
+
+func TestSecretsNotRevealed(t *testing.T) {
+	if err := os.Setenv("LAGOON_SECRET_REVEAL", "resolved-secret"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("LAGOON_SECRET_REVEAL")
+	config := []byte(`projects:
+- name: bananas
+  gitUrl: git@github.com:amazeeio/bananas.git
+  productionEnvironment: master
+  privateKey: {fromEnv: LAGOON_SECRET_REVEAL}
+  envVariables:
+  - name: FOO
+    scope: build
+    value: {fromEnv: LAGOON_SECRET_REVEAL}
+  notifications:
+    slack:
+    - example-slack
+notifications:
+  slack:
+  - name: example-slack
+    webhook: {fromEnv: LAGOON_SECRET_REVEAL}
+    channel: bananas
+`)
+	// the current secret values must not be revealed either
+	secrets := []string{"resolved-secret", "current-secret"}
+	outputs := map[string]string{}
+
+	// dry-run import
+	c, recorder := client.NewDryRun("1.0.0")
+	err := lagoon.Import(context.Background(), c, bytes.NewReader(config),
+		false, 1, 1, nil)
+	if err != nil {
+		t.Fatalf("couldn't import: %v", err)
+	}
+	data, err := json.Marshal(recorder.Requests())
+	if err != nil {
+		t.Fatalf("couldn't marshal requests: %v", err)
+	}
+	outputs["dry-run"] = string(data)
+
+	// plan and unified diff against an existing project
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	applier := mock.NewMockApplier(ctrl)
+	applier.EXPECT().ProjectByName(ctx, "bananas", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, project *schema.Project) error {
+			*project = currentBananas()
+			project.PrivateKey = "current-secret"
+			for i := range project.EnvVariables {
+				project.EnvVariables[i].Value = "current-secret"
+			}
+			project.Notifications.Slack[0].Webhook = "current-secret"
+			return nil
+		}).AnyTimes()
+	parsed, err := lagoon.ParseConfig(bytes.NewReader(config))
+	if err != nil {
+		t.Fatalf("couldn't parse config: %v", err)
+	}
+	plan, err := lagoon.PlanApply(ctx, applier, parsed, true, 1)
+	if err != nil {
+		t.Fatalf("couldn't plan: %v", err)
+	}
+	if data, err = json.Marshal(plan); err != nil {
+		t.Fatalf("couldn't marshal plan: %v", err)
+	}
+	outputs["plan"] = string(data)
+	outputs["plan fields"] = fmt.Sprint(plan.Changes)
+	outputs["unified"], err = lagoon.DiffConfig(ctx, applier, parsed, nil)
+	if err != nil {
+		t.Fatalf("couldn't diff config: %v", err)
+	}
+	if !strings.Contains(outputs["unified"], "(sensitive) (changed)") {
+		t.Errorf("expected changed secrets in unified diff:\n%s",
+			outputs["unified"])
+	}
+
+	for name, out := range outputs {
+		for _, secret := range secrets {
+			if strings.Contains(out, secret) {
+				t.Errorf("%s output reveals %q:\n%s", name, secret, out)
+			}
+		}
+	}
+}
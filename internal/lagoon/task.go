@@ -0,0 +1,134 @@
+package lagoon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+)
+
+// Tasks interface contains methods for running tasks on environments and
+// getting info on them.
+type Tasks interface {
+	Environments
+	TasksByEnvironment(ctx context.Context, name string, projectID uint,
+		tasks *[]schema.Task) error
+	TaskDrushArchiveDump(ctx context.Context, environmentID uint,
+		out *schema.Task) error
+	TaskDrushSQLDump(ctx context.Context, environmentID uint,
+		out *schema.Task) error
+	TaskDrushCacheClear(ctx context.Context, environmentID uint,
+		out *schema.Task) error
+	AddTask(ctx context.Context, in *schema.AddTaskInput,
+		out *schema.Task) error
+}
+
+// GetTasks gets the tasks of an environment by project and environment name.
+func GetTasks(ctx context.Context, t Tasks,
+	projectName, environmentName string) ([]schema.Task, error) {
+	project, err := GetProjectInfo(ctx, t, projectName)
+	if err != nil {
+		return nil, err
+	}
+	tasks := []schema.Task{}
+	err = t.TasksByEnvironment(ctx, environmentName, project.ID, &tasks)
+	return tasks, err
+}
+
+// runTask runs a task on an environment by project and environment name.
+func runTask(ctx context.Context, t Tasks, projectName, environmentName string,
+	run func(context.Context, uint, *schema.Task) error) (*schema.Task, error) {
+	environment, err := GetEnvironment(ctx, t, projectName, environmentName)
+	if err != nil {
+		return nil, err
+	}
+	task := schema.Task{}
+	return &task, run(ctx, environment.ID, &task)
+}
+
+// RunDrushArchiveDump runs a drush archive-dump task on an environment.
+func RunDrushArchiveDump(ctx context.Context, t Tasks,
+	projectName, environmentName string) (*schema.Task, error) {
+	return runTask(ctx, t, projectName, environmentName, t.TaskDrushArchiveDump)
+}
+
+// RunDrushSQLDump runs a drush sql-dump task on an environment.
+func RunDrushSQLDump(ctx context.Context, t Tasks,
+	projectName, environmentName string) (*schema.Task, error) {
+	return runTask(ctx, t, projectName, environmentName, t.TaskDrushSQLDump)
+}
+
+// RunDrushCacheClear runs a drush cache-clear task on an environment.
+func RunDrushCacheClear(ctx context.Context, t Tasks,
+	projectName, environmentName string) (*schema.Task, error) {
+	return runTask(ctx, t, projectName, environmentName, t.TaskDrushCacheClear)
+}
+
+// RunCustomTask runs a custom task on an environment. The environment and
+// execute fields of the given task input are set by this function.
+func RunCustomTask(ctx context.Context, t Tasks, projectName,
+	environmentName string, in schema.AddTaskInput) (*schema.Task, error) {
+	return runTask(ctx, t, projectName, environmentName,
+		func(ctx context.Context, environmentID uint, task *schema.Task) error {
+			in.Environment, in.Execute = environmentID, true
+			return t.AddTask(ctx, &in, task)
+		})
+}
+
+// TaskInfo interface contains methods for getting info on a task.
+type TaskInfo interface {
+	TaskByID(ctx context.Context, id uint, task *schema.Task) error
+}
+
+// GetTask gets a task, including its logs and files, by its ID.
+func GetTask(ctx context.Context, t TaskInfo, id uint) (*schema.Task, error) {
+	task := schema.Task{}
+	if err := t.TaskByID(ctx, id, &task); err != nil {
+		return nil, err
+	}
+	if task.ID == 0 {
+		return nil, fmt.Errorf("task %d: %w", id, ErrNotFound)
+	}
+	return &task, nil
+}
+
+// TaskFinished returns true if a task with the given status has finished. The
+// Lagoon API returns statuses in lower case.
+func TaskFinished(status api.TaskStatusType) bool {
+	for _, finished := range []api.TaskStatusType{api.SucceededTask,
+		api.CompleteTask, api.FailedTask, api.ErrorTask, api.CancelledTask} {
+		if strings.EqualFold(string(status), string(finished)) {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitForTask polls a task every interval until it has finished, and returns
+// it. progress is called with the task whenever its status changes.
+func WaitForTask(ctx context.Context, t TaskInfo, id uint,
+	interval time.Duration,
+	progress func(*schema.Task)) (*schema.Task, error) {
+	var status api.TaskStatusType
+	for {
+		task, err := GetTask(ctx, t, id)
+		if err != nil {
+			return nil, err
+		}
+		if task.Status != status {
+			status = task.Status
+			progress(task)
+		}
+		if TaskFinished(task.Status) {
+			return task, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
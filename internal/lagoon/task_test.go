@@ -0,0 +1,106 @@
+//go:generate mockgen -source=task.go -destination=../mock/mock_tasks.go -package=mock -aux_files=github.com/amazeeio/lagoon-cli/internal/lagoon=environment.go
+package lagoon_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/mock"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+	"github.com/golang/mock/gomock"
+)
+
+func TestRunCustomTask(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tasks := mock.NewMockTasks(ctrl)
+	tasks.EXPECT().ProjectInfoByName(ctx, "bananas", gomock.Any()).
+		DoAndReturn(setProject(bananas(7)))
+	tasks.EXPECT().EnvironmentByName(ctx, "master", uint(7), gomock.Any()).
+		DoAndReturn(setEnvironment(master(8)))
+	tasks.EXPECT().AddTask(ctx, &schema.AddTaskInput{
+		Name:        "hello",
+		Environment: 8,
+		Service:     "cli",
+		Command:     "echo hello",
+		Execute:     true,
+	}, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *schema.AddTaskInput, out *schema.Task) error {
+			out.ID = 42
+			return nil
+		})
+	task, err := lagoon.RunCustomTask(ctx, tasks, "bananas", "master",
+		schema.AddTaskInput{
+			Name:    "hello",
+			Service: "cli",
+			Command: "echo hello",
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if task.ID != 42 {
+		t.Fatalf("expected task 42, got %d", task.ID)
+	}
+}
+
+func TestRunDrushCacheClear(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tasks := mock.NewMockTasks(ctrl)
+	tasks.EXPECT().ProjectInfoByName(ctx, "bananas", gomock.Any()).
+		DoAndReturn(setProject(bananas(7)))
+	tasks.EXPECT().EnvironmentByName(ctx, "master", uint(7), gomock.Any()).
+		DoAndReturn(setEnvironment(master(8)))
+	tasks.EXPECT().TaskDrushCacheClear(ctx, uint(8), gomock.Any())
+	if _, err := lagoon.RunDrushCacheClear(ctx, tasks, "bananas",
+		"master"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWaitForTask(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tasks := mock.NewMockTaskInfo(ctrl)
+	var calls []*gomock.Call
+	for _, status := range []api.TaskStatusType{"pending", "running",
+		"running", "succeeded"} {
+		calls = append(calls, tasks.EXPECT().TaskByID(ctx, uint(42),
+			gomock.Any()).SetArg(2, schema.Task{ID: 42, Status: status}))
+	}
+	gomock.InOrder(calls...)
+	var statuses []api.TaskStatusType
+	task, err := lagoon.WaitForTask(ctx, tasks, 42, time.Millisecond,
+		func(task *schema.Task) {
+			statuses = append(statuses, task.Status)
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if task.Status != "succeeded" {
+		t.Fatalf("unexpected task: %v", task)
+	}
+	expected := []api.TaskStatusType{"pending", "running", "succeeded"}
+	if !reflect.DeepEqual(statuses, expected) {
+		t.Fatalf("expected progress %v, got %v", expected, statuses)
+	}
+}
+
+func TestGetTaskNotFound(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tasks := mock.NewMockTaskInfo(ctrl)
+	tasks.EXPECT().TaskByID(ctx, uint(42), gomock.Any()).Return(nil)
+	if _, err := lagoon.GetTask(ctx, tasks, 42); !errors.Is(err, lagoon.ErrNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
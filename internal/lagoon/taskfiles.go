@@ -0,0 +1,115 @@
+package lagoon
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+// DownloadedFile is a task file which was downloaded to a local directory.
+type DownloadedFile struct {
+	Path   string
+	Size   int64
+	SHA256 string
+}
+
+// progressWriter calls progress with the number of bytes written so far.
+type progressWriter struct {
+	written  int64
+	total    int64
+	progress func(written, total int64)
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.written += int64(len(p))
+	w.progress(w.written, w.total)
+	return len(p), nil
+}
+
+// taskFileName returns the base name of a task file, which is the name it is
+// downloaded as. Names which aren't a file, such as "" or "..", are rejected.
+func taskFileName(filename string) (string, error) {
+	base := filepath.Base(filename)
+	switch base {
+	case ".", "..", string(filepath.Separator):
+		return "", fmt.Errorf("invalid task file name %q", filename)
+	}
+	return base, nil
+}
+
+// TaskFileDirs returns the directory within dir to download each of the task
+// files to, by file ID. Files are downloaded to dir itself, unless another
+// file of the task has the same base name, in which case each of these files
+// is downloaded to a subdirectory of dir named after its ID.
+func TaskFileDirs(files []schema.File, dir string) (map[uint]string, error) {
+	count := map[string]int{}
+	for _, f := range files {
+		name, err := taskFileName(f.Filename)
+		if err != nil {
+			return nil, err
+		}
+		count[name]++
+	}
+	dirs := map[uint]string{}
+	for _, f := range files {
+		dirs[f.ID] = dir
+		if count[filepath.Base(f.Filename)] > 1 {
+			dirs[f.ID] = filepath.Join(dir, fmt.Sprintf("%d", f.ID))
+		}
+	}
+	return dirs, nil
+}
+
+// DownloadTaskFile downloads the file of a task from its download URL into
+// dir, and returns its path, size and SHA-256 checksum. progress is called as
+// the file is written, with the total size or -1 if it is unknown. Only the
+// base name of the file is used, so that a file can't be written outside dir.
+func DownloadTaskFile(ctx context.Context, httpClient *http.Client, url,
+	filename, dir string,
+	progress func(written, total int64)) (*DownloadedFile, error) {
+	name, err := taskFileName(filename)
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, name)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("couldn't download %s: %s", filename, res.Status)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, hash, &progressWriter{
+		total:    res.ContentLength,
+		progress: progress,
+	}), res.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return nil, fmt.Errorf("couldn't download %s: %w", filename, err)
+	}
+	return &DownloadedFile{
+		Path:   path,
+		Size:   n,
+		SHA256: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
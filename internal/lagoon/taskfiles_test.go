@@ -0,0 +1,90 @@
+package lagoon_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+func TestDownloadTaskFile(t *testing.T) {
+	content := []byte("CREATE TABLE bananas;\n")
+	ts := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/dump.sql" {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write(content)
+		}))
+	defer ts.Close()
+	dir, err := ioutil.TempDir("", "lagoon-task-files")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	var written int64
+	file, err := lagoon.DownloadTaskFile(context.Background(), ts.Client(),
+		ts.URL+"/dump.sql", "../../dump.sql", dir,
+		func(n, _ int64) { written = n })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sum := sha256.Sum256(content)
+	if file.Path != filepath.Join(dir, "dump.sql") ||
+		file.Size != int64(len(content)) || written != file.Size ||
+		file.SHA256 != hex.EncodeToString(sum[:]) {
+		t.Fatalf("unexpected file: %+v", file)
+	}
+	data, err := ioutil.ReadFile(file.Path)
+	if err != nil || string(data) != string(content) {
+		t.Fatalf("unexpected content %q, error %v", data, err)
+	}
+	_, err = lagoon.DownloadTaskFile(context.Background(), ts.Client(),
+		ts.URL+"/missing.sql", "missing.sql", dir, func(_, _ int64) {})
+	if err == nil {
+		t.Fatal("expected an error for a missing file")
+	}
+	if _, err = os.Stat(filepath.Join(dir, "missing.sql")); !os.IsNotExist(err) {
+		t.Fatalf("expected no file to be written, got %v", err)
+	}
+	for _, name := range []string{"", ".", "..", "dumps/..", "/"} {
+		_, err = lagoon.DownloadTaskFile(context.Background(), ts.Client(),
+			ts.URL+"/dump.sql", name, dir, func(_, _ int64) {})
+		if err == nil {
+			t.Fatalf("expected an error for file name %q", name)
+		}
+	}
+}
+
+func TestTaskFileDirs(t *testing.T) {
+	dirs, err := lagoon.TaskFileDirs([]schema.File{
+		{ID: 1, Filename: "a/dump.sql"},
+		{ID: 2, Filename: "b/dump.sql"},
+		{ID: 3, Filename: "files.tar.gz"},
+	}, "out")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expect := map[uint]string{
+		1: filepath.Join("out", "1"),
+		2: filepath.Join("out", "2"),
+		3: "out",
+	}
+	if !reflect.DeepEqual(dirs, expect) {
+		t.Fatalf("expected %v, got %v", expect, dirs)
+	}
+	_, err = lagoon.TaskFileDirs([]schema.File{{ID: 1, Filename: ".."}}, "out")
+	if err == nil {
+		t.Fatal("expected an error for an invalid file name")
+	}
+}
@@ -0,0 +1,130 @@
+package lagoon
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/yaml"
+)
+
+// templateFuncs are the functions available in config templates, in addition
+// to the text/template builtins.
+var templateFuncs = template.FuncMap{
+	// env returns the value of an environment variable.
+	"env": os.Getenv,
+	// required fails rendering if value is empty.
+	"required": func(msg string, value interface{}) (interface{}, error) {
+		if value == nil || value == "" {
+			return nil, fmt.Errorf("%s", msg)
+		}
+		return value, nil
+	},
+	// default returns def if value is empty.
+	"default": func(def, value interface{}) interface{} {
+		if value == nil || value == "" {
+			return def
+		}
+		return value
+	},
+	// get returns m[key], or def if the key is missing.
+	"get": func(m map[string]interface{}, key string,
+		def ...interface{}) interface{} {
+		if value, ok := m[key]; ok {
+			return value
+		}
+		if len(def) > 0 {
+			return def[0]
+		}
+		return ""
+	},
+	// quote returns value as a double-quoted YAML string.
+	"quote": func(value interface{}) string {
+		return fmt.Sprintf("%q", fmt.Sprint(value))
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+}
+
+// RenderConfig renders a config file template with text/template. The values
+// are available in the template as .Values, and environment variables as .Env.
+// Referring to a missing value is an error.
+func RenderConfig(data []byte, values map[string]interface{}) ([]byte, error) {
+	tmpl, err := template.New("config").Funcs(templateFuncs).
+		Option("missingkey=error").Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse template: %w", err)
+	}
+	env := map[string]string{}
+	for _, kv := range os.Environ() {
+		if i := strings.Index(kv, "="); i > 0 {
+			env[kv[:i]] = kv[i+1:]
+		}
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, map[string]interface{}{
+		"Values": values,
+		"Env":    env,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't render template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// ParseValues reads the given YAML values files in order, and then applies
+// the key=value pairs in set. Later values override earlier ones, and maps
+// are merged. Keys in set may be dotted to set nested values, e.g.
+// project.name=bananas.
+func ParseValues(files, set []string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read values file: %w", err)
+		}
+		fileValues := map[string]interface{}{}
+		if err = yaml.Unmarshal(data, &fileValues); err != nil {
+			return nil, fmt.Errorf(
+				"couldn't unmarshal values file %s: %w", file, err)
+		}
+		mergeValues(values, fileValues)
+	}
+	for _, kv := range set {
+		i := strings.Index(kv, "=")
+		if i < 1 {
+			return nil, fmt.Errorf(`invalid value "%s", expected key=value`, kv)
+		}
+		keys := strings.Split(kv[:i], ".")
+		m := values
+		for _, key := range keys[:len(keys)-1] {
+			next, ok := m[key].(map[string]interface{})
+			if !ok {
+				next = map[string]interface{}{}
+				m[key] = next
+			}
+			m = next
+		}
+		m[keys[len(keys)-1]] = kv[i+1:]
+	}
+	return values, nil
+}
+
+// mergeValues merges src into dst recursively.
+func mergeValues(dst, src map[string]interface{}) {
+	for k, v := range src {
+		srcMap, srcOK := v.(map[string]interface{})
+		dstMap, dstOK := dst[k].(map[string]interface{})
+		if srcOK && dstOK {
+			mergeValues(dstMap, srcMap)
+			continue
+		}
+		dst[k] = v
+	}
+}
@@ -0,0 +1,111 @@
+package lagoon_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+)
+
+func TestRenderConfig(t *testing.T) {
+	var testCases = map[string]struct {
+		input  string
+		values []string
+		set    []string
+		expect string
+	}{
+		"projects": {
+			input:  "testdata/template.import.yaml",
+			values: []string{"testdata/template.values.yaml"},
+			set:    []string{"group=vegetables"},
+			expect: "testdata/template.golden.yaml",
+		},
+	}
+	if err := os.Setenv("LAGOON_TEMPLATE_TEST_USER", "foo bar"); err != nil {
+		t.Fatalf("couldn't set environment: %v", err)
+	}
+	defer os.Unsetenv("LAGOON_TEMPLATE_TEST_USER")
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			data, err := ioutil.ReadFile(tc.input)
+			if err != nil {
+				tt.Fatalf("couldn't read file: %v", err)
+			}
+			values, err := lagoon.ParseValues(tc.values, tc.set)
+			if err != nil {
+				tt.Fatalf("couldn't parse values: %v", err)
+			}
+			result, err := lagoon.RenderConfig(data, values)
+			if err != nil {
+				tt.Fatalf("couldn't render config: %v", err)
+			}
+			// the rendered config must be importable
+			if _, err = lagoon.ParseConfig(bytes.NewReader(result)); err != nil {
+				tt.Fatalf("couldn't parse rendered config: %v", err)
+			}
+
+			if *update {
+				tt.Logf("update golden file: %s", tc.expect)
+				if err = ioutil.WriteFile(tc.expect, result, 0644); err != nil {
+					tt.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+
+			expected, err := ioutil.ReadFile(tc.expect)
+			if err != nil {
+				tt.Fatalf("failed reading golden file: %v", err)
+			}
+			if !bytes.Equal(result, expected) {
+				tt.Logf("result:\n%s\nexpected:\n%s", result, expected)
+				tt.Errorf("result does not match expected")
+			}
+		})
+	}
+}
+
+func TestRenderConfigMissingValue(t *testing.T) {
+	_, err := lagoon.RenderConfig([]byte("name: {{ .Values.name }}\n"), nil)
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
+
+func TestParseValues(t *testing.T) {
+	var testCases = map[string]struct {
+		set    []string
+		expect map[string]interface{}
+		valid  bool
+	}{
+		"nested": {
+			set: []string{"a.b=c", "a.d=e", "f=g=h"},
+			expect: map[string]interface{}{
+				"a": map[string]interface{}{"b": "c", "d": "e"},
+				"f": "g=h",
+			},
+			valid: true,
+		},
+		"invalid": {
+			set: []string{"novalue"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			values, err := lagoon.ParseValues(nil, tc.set)
+			if !tc.valid {
+				if err == nil {
+					tt.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("couldn't parse values: %v", err)
+			}
+			if !reflect.DeepEqual(values, tc.expect) {
+				tt.Fatalf("expected %v, got %v", tc.expect, values)
+			}
+		})
+	}
+}
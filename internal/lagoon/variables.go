@@ -0,0 +1,85 @@
+package lagoon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+)
+
+// EnvVariables interface contains methods for managing the envVariables of
+// projects and environments.
+type EnvVariables interface {
+	Environments
+	AddEnvVariable(ctx context.Context, in *schema.EnvVariableInput,
+		out *schema.EnvKeyValue) error
+	DeleteEnvVariable(ctx context.Context, in *schema.DeleteEnvVariableInput,
+		out *string) error
+}
+
+// GetEnvVariables gets the envVariables of a project, or of an environment
+// if environmentName is not empty.
+func GetEnvVariables(ctx context.Context, e Environments,
+	projectName, environmentName string) ([]schema.EnvKeyValue, error) {
+	if environmentName == "" {
+		project, err := GetProjectInfo(ctx, e, projectName)
+		if err != nil {
+			return nil, err
+		}
+		return project.EnvVariables, nil
+	}
+	environment, err := GetEnvironment(ctx, e, projectName, environmentName)
+	if err != nil {
+		return nil, err
+	}
+	return environment.EnvVariables, nil
+}
+
+// AddEnvVariable adds an envVariable to a project, or to an environment if
+// environmentName is not empty.
+func AddEnvVariable(ctx context.Context, v EnvVariables,
+	projectName, environmentName string,
+	envVar schema.EnvKeyValue) (*schema.EnvKeyValue, error) {
+	in := schema.EnvVariableInput{EnvKeyValue: envVar}
+	if environmentName == "" {
+		project, err := GetProjectInfo(ctx, v, projectName)
+		if err != nil {
+			return nil, err
+		}
+		in.Type, in.TypeID = api.ProjectVar, project.ID
+	} else {
+		environment, err := GetEnvironment(ctx, v, projectName, environmentName)
+		if err != nil {
+			return nil, err
+		}
+		in.Type, in.TypeID = api.EnvironmentVar, environment.ID
+	}
+	out := schema.EnvKeyValue{}
+	return &out, v.AddEnvVariable(ctx, &in, &out)
+}
+
+// DeleteEnvVariable deletes an envVariable by name from a project, or from an
+// environment if environmentName is not empty. It returns the result of the
+// deletion.
+func DeleteEnvVariable(ctx context.Context, v EnvVariables,
+	projectName, environmentName, name string) (string, error) {
+	envVars, err := GetEnvVariables(ctx, v, projectName, environmentName)
+	if err != nil {
+		return "", err
+	}
+	var id uint
+	for _, envVar := range envVars {
+		if envVar.Name == name {
+			id = envVar.ID
+			break
+		}
+	}
+	if id == 0 {
+		return "", fmt.Errorf("matching var %w", ErrNotFound)
+	}
+	var result string
+	err = v.DeleteEnvVariable(ctx,
+		&schema.DeleteEnvVariableInput{ID: id}, &result)
+	return result, err
+}
@@ -0,0 +1,110 @@
+//go:generate mockgen -source=variables.go -destination=../mock/mock_envvariables.go -package=mock -aux_files=github.com/amazeeio/lagoon-cli/internal/lagoon=environment.go
+package lagoon_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/lagoon"
+	"github.com/amazeeio/lagoon-cli/internal/mock"
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+	"github.com/golang/mock/gomock"
+)
+
+func TestAddEnvVariable(t *testing.T) {
+	envVar := schema.EnvKeyValue{
+		Name:  "FOO",
+		Scope: api.RuntimeVar,
+		Value: "bar",
+	}
+	var testCases = map[string]struct {
+		environment string
+		expect      *schema.EnvVariableInput
+	}{
+		"project": {
+			expect: &schema.EnvVariableInput{
+				EnvKeyValue: envVar,
+				Type:        api.ProjectVar,
+				TypeID:      7,
+			},
+		},
+		"environment": {
+			environment: "master",
+			expect: &schema.EnvVariableInput{
+				EnvKeyValue: envVar,
+				Type:        api.EnvironmentVar,
+				TypeID:      8,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+			v := mock.NewMockEnvVariables(ctrl)
+			v.EXPECT().ProjectInfoByName(ctx, "bananas", gomock.Any()).
+				DoAndReturn(setProject(bananas(7)))
+			if tc.environment != "" {
+				v.EXPECT().EnvironmentByName(ctx, tc.environment, uint(7),
+					gomock.Any()).DoAndReturn(setEnvironment(master(8)))
+			}
+			v.EXPECT().AddEnvVariable(ctx, tc.expect, gomock.Any())
+			_, err := lagoon.AddEnvVariable(ctx, v, "bananas", tc.environment,
+				envVar)
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestDeleteEnvVariable(t *testing.T) {
+	var testCases = map[string]struct {
+		name      string
+		expectID  uint
+		expectErr bool
+	}{
+		"found":    {name: "FOO", expectID: 3},
+		"notFound": {name: "BAR", expectErr: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+			v := mock.NewMockEnvVariables(ctrl)
+			project := bananas(7)
+			project.EnvVariables = []schema.EnvKeyValue{
+				{ID: 3, Name: "FOO", Scope: api.BuildVar, Value: "baz"},
+				{ID: 4, Name: "OLD", Scope: api.RuntimeVar, Value: "old"},
+			}
+			v.EXPECT().ProjectInfoByName(ctx, "bananas", gomock.Any()).
+				DoAndReturn(setProject(project))
+			if !tc.expectErr {
+				v.EXPECT().DeleteEnvVariable(ctx,
+					&schema.DeleteEnvVariableInput{ID: tc.expectID}, gomock.Any()).
+					DoAndReturn(func(_ context.Context,
+						_ *schema.DeleteEnvVariableInput, out *string) error {
+						*out = "success"
+						return nil
+					})
+			}
+			result, err := lagoon.DeleteEnvVariable(ctx, v, "bananas", "",
+				tc.name)
+			if tc.expectErr {
+				if err == nil {
+					tt.Fatalf("expected error, got result %q", result)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if result != "success" {
+				tt.Fatalf("expected result success, got %q", result)
+			}
+		})
+	}
+}
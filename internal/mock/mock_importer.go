@@ -216,6 +216,20 @@ func (mr *MockImporterMockRecorder) EnvironmentByName(arg0, arg1, arg2, arg3 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentByName", reflect.TypeOf((*MockImporter)(nil).EnvironmentByName), arg0, arg1, arg2, arg3)
 }
 
+// GroupByName mocks base method
+func (m *MockImporter) GroupByName(arg0 context.Context, arg1 string, arg2 *schema.Groups) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupByName", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GroupByName indicates an expected call of GroupByName
+func (mr *MockImporterMockRecorder) GroupByName(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupByName", reflect.TypeOf((*MockImporter)(nil).GroupByName), arg0, arg1, arg2)
+}
+
 // AddGroupsToProject mocks base method
 func (m *MockImporter) AddGroupsToProject(arg0 context.Context, arg1 *schema.ProjectGroupsInput, arg2 *schema.Project) error {
 	m.ctrl.T.Helper()
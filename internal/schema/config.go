@@ -21,6 +21,31 @@ type Config struct {
 	Notifications *NotificationsConfig   `json:"notifications,omitempty"`
 }
 
+// These are the lowercase aliases accepted in config files for Lagoon API
+// enum values.
+var (
+	sshKeyTypeAliases = map[api.SSHKeyType]api.SSHKeyType{
+		"ssh-rsa":     api.SSHRsa,
+		"ssh-ed25519": api.SSHEd25519,
+	}
+	deployTypeAliases = map[api.DeployType]api.DeployType{
+		"branch":      api.Branch,
+		"pullrequest": api.PullRequest,
+		"promote":     api.Promote,
+	}
+	envTypeAliases = map[api.EnvType]api.EnvType{
+		"production":  api.ProductionEnv,
+		"development": api.DevelopmentEnv,
+	}
+	envVarScopeAliases = map[api.EnvVariableScope]api.EnvVariableScope{
+		"build":                       api.BuildVar,
+		"runtime":                     api.RuntimeVar,
+		"global":                      api.GlobalVar,
+		"internal_container_registry": api.InternalContainerRegistryVar,
+		"container_registry":          api.ContainerRegistryVar,
+	}
+)
+
 // UnmarshalJSON implements json.Unmarshaler interface to control how lagoon
 // config files are unmarshaled.
 func (c *Config) UnmarshalJSON(data []byte) error {
@@ -28,52 +53,37 @@ func (c *Config) UnmarshalJSON(data []byte) error {
 	// during json.Unmarshal()
 	type UnmarshalConfig Config
 	var uc UnmarshalConfig
+	// secret references aren't plain strings, so they are encoded first
+	data, err := unmarshalSecretRefs(data)
+	if err != nil {
+		return err
+	}
 	if err := json.Unmarshal(data, &uc); err != nil {
 		return err
 	}
 	// post-process the unmarshaled object to Lagoon API requirements
-	sshKeyType := map[api.SSHKeyType]api.SSHKeyType{
-		"ssh-rsa":     api.SSHRsa,
-		"ssh-ed25519": api.SSHEd25519,
-	}
 	for _, user := range uc.Users {
 		for j, sshKey := range user.SSHKeys {
-			if val, ok := sshKeyType[sshKey.KeyType]; ok {
+			if val, ok := sshKeyTypeAliases[sshKey.KeyType]; ok {
 				user.SSHKeys[j].KeyType = val
 			}
 		}
 	}
-	environmentDeployType := map[api.DeployType]api.DeployType{
-		"branch":      api.Branch,
-		"pullrequest": api.PullRequest,
-		"promote":     api.Promote,
-	}
-	environmentType := map[api.EnvType]api.EnvType{
-		"production":  api.ProductionEnv,
-		"development": api.DevelopmentEnv,
-	}
-	envVarScope := map[api.EnvVariableScope]api.EnvVariableScope{
-		"build":                       api.BuildVar,
-		"runtime":                     api.RuntimeVar,
-		"global":                      api.GlobalVar,
-		"internal_container_registry": api.InternalContainerRegistryVar,
-		"container_registry":          api.ContainerRegistryVar,
-	}
 	for _, project := range uc.Projects {
 		for j, ev := range project.EnvVariables {
-			if val, ok := envVarScope[ev.Scope]; ok {
+			if val, ok := envVarScopeAliases[ev.Scope]; ok {
 				project.EnvVariables[j].Scope = val
 			}
 		}
 		for j, env := range project.Environments {
-			if val, ok := environmentDeployType[env.DeployType]; ok {
+			if val, ok := deployTypeAliases[env.DeployType]; ok {
 				project.Environments[j].DeployType = val
 			}
-			if val, ok := environmentType[env.EnvironmentType]; ok {
+			if val, ok := envTypeAliases[env.EnvironmentType]; ok {
 				project.Environments[j].EnvironmentType = val
 			}
 			for k, ev := range env.EnvVariables {
-				if val, ok := envVarScope[ev.Scope]; ok {
+				if val, ok := envVarScopeAliases[ev.Scope]; ok {
 					project.Environments[j].EnvVariables[k].Scope = val
 				}
 			}
@@ -207,11 +217,19 @@ func ProjectsToConfig(
 			config.Notifications.MicrosoftTeams =
 				append(config.Notifications.MicrosoftTeams, n)
 		}
-		minimiseProjectConfig(&projectConfig, exclude)
 		config.Projects = append(config.Projects, projectConfig)
 	}
+	config.escapeSecretRefs()
 
-	minimiseConfig(&config, exclude)
+	return MarshalConfigYAML(&config, exclude)
+}
+
+// MarshalConfigYAML minimises the given Config and marshals it to YAML.
+func MarshalConfigYAML(config *Config, exclude map[string]bool) ([]byte, error) {
+	for i := range config.Projects {
+		minimiseProjectConfig(&config.Projects[i], exclude)
+	}
+	minimiseConfig(config, exclude)
 
 	// logic copied from yaml.Marshal() to be able to clean the JSON to ensure
 	// valid UTF-8 before converting to YAML because otherwise the YAML library
@@ -220,6 +238,11 @@ func ProjectsToConfig(
 	if err != nil {
 		return nil, fmt.Errorf("couldn't marshal to JSON: %v", err)
 	}
+	// marshal any secret references as objects
+	j, err = marshalSecretRefs(j)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal secret references: %v", err)
+	}
 	// strip any non-printable runes
 	j = bytes.Map(func(r rune) rune {
 		if unicode.IsPrint(r) {
@@ -239,6 +262,12 @@ func minimiseProjectConfig(p *ProjectConfig, exclude map[string]bool) {
 	// omit IDs from config
 	for i := range p.Environments {
 		p.Environments[i].ID = 0
+		for j := range p.Environments[i].EnvVariables {
+			p.Environments[i].EnvVariables[j].ID = 0
+		}
+	}
+	for i := range p.EnvVariables {
+		p.EnvVariables[i].ID = 0
 	}
 	p.ID = 0
 	// clear exclusions
@@ -260,7 +289,7 @@ func minimiseProjectConfig(p *ProjectConfig, exclude map[string]bool) {
 	}
 
 	// don't set options if they're already set to default values
-	defaults := projectDefaults()
+	defaults := ProjectDefaults()
 	if p.ActiveSystemsDeploy == defaults.ActiveSystemsDeploy {
 		p.ActiveSystemsDeploy = ""
 	}
@@ -305,8 +334,8 @@ func minimiseConfig(c *Config, exclude map[string]bool) {
 	}
 }
 
-// projectDefaults returns default Project values.
-func projectDefaults() *ProjectConfig {
+// ProjectDefaults returns default Project values.
+func ProjectDefaults() *ProjectConfig {
 	// see https://github.com/amazeeio/lagoon/blob/
 	// 817def93b3e15f5d96aa44e2b7bd33c15f18bd43
 	// services/api/src/resources/project/resolvers.js#L233
@@ -0,0 +1,56 @@
+package schema
+
+import "github.com/amazeeio/lagoon-cli/pkg/api"
+
+// Deployment is the Lagoon API Deployment object.
+type Deployment struct {
+	ID       uint                     `json:"id"`
+	Name     string                   `json:"name"`
+	Status   api.DeploymentStatusType `json:"status"`
+	RemoteID string                   `json:"remoteId"`
+	BuildLog string                   `json:"buildLog,omitempty"`
+	// TODO use a unixtime type
+	Created   string `json:"created"`
+	Started   string `json:"started"`
+	Completed string `json:"completed"`
+}
+
+// DeployEnvironmentBranchInput is based on the input to
+// deployEnvironmentBranch.
+type DeployEnvironmentBranchInput struct {
+	Project    ProjectInput `json:"project"`
+	BranchName string       `json:"branchName"`
+	BranchRef  string       `json:"branchRef,omitempty"`
+}
+
+// DeployEnvironmentPromoteInput is based on the input to
+// deployEnvironmentPromote.
+type DeployEnvironmentPromoteInput struct {
+	SourceEnvironment      EnvironmentInput `json:"sourceEnvironment"`
+	Project                ProjectInput     `json:"project"`
+	DestinationEnvironment string           `json:"destinationEnvironment"`
+}
+
+// DeployEnvironmentPullrequestInput is based on the input to
+// deployEnvironmentPullrequest.
+type DeployEnvironmentPullrequestInput struct {
+	Project        ProjectInput `json:"project"`
+	Number         uint         `json:"number"`
+	Title          string       `json:"title"`
+	BaseBranchName string       `json:"baseBranchName"`
+	BaseBranchRef  string       `json:"baseBranchRef"`
+	HeadBranchName string       `json:"headBranchName"`
+	HeadBranchRef  string       `json:"headBranchRef"`
+}
+
+// DeploymentInput is based on the Lagoon API type.
+type DeploymentInput struct {
+	ID          uint              `json:"id,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Environment *EnvironmentInput `json:"environment,omitempty"`
+}
+
+// CancelDeploymentInput is based on the input to cancelDeployment.
+type CancelDeploymentInput struct {
+	Deployment DeploymentInput `json:"deployment"`
+}
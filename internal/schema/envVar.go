@@ -16,3 +16,8 @@ type EnvVariableInput struct {
 	Type   api.EnvVariableType `json:"type"`
 	TypeID uint                `json:"typeId"`
 }
+
+// DeleteEnvVariableInput is based on the input to deleteEnvVariable.
+type DeleteEnvVariableInput struct {
+	ID uint `json:"id"`
+}
@@ -23,15 +23,44 @@ type Environment struct {
 	EnvVariables []EnvKeyValue `json:"envVariables,omitempty"`
 	Route        string        `json:"route,omitempty"`
 	Routes       string        `json:"routes,omitempty"`
+	// MonitoringURLs is a comma-separated list of URLs to monitor.
+	MonitoringURLs string `json:"monitoringUrls,omitempty"`
 	// TODO use a unixtime type
 	Updated string `json:"updated,omitempty"`
 	Created string `json:"created,omitempty"`
 	Deleted string `json:"deleted,omitempty"`
 }
 
+// EnvironmentService is based on the Lagoon API type.
+type EnvironmentService struct {
+	ID   uint   `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// EnvironmentServices is unmarshalled from an environmentsByProjectName query
+// response.
+type EnvironmentServices struct {
+	Name     string               `json:"name"`
+	Services []EnvironmentService `json:"services"`
+}
+
 // EnvironmentConfig contains Environment configuration.
 type EnvironmentConfig struct {
 	Environment
 	// override embedded AddEnvironmentInput.ProjectID to omitempty
 	ProjectID uint `json:"project,omitempty"`
 }
+
+// DeleteEnvironmentInput is based on the input to deleteEnvironment.
+type DeleteEnvironmentInput struct {
+	Name    string `json:"name"`
+	Project string `json:"project"`
+	Execute bool   `json:"execute,omitempty"`
+}
+
+// EnvironmentInput is based on the Lagoon API type.
+type EnvironmentInput struct {
+	ID      uint          `json:"id,omitempty"`
+	Name    string        `json:"name,omitempty"`
+	Project *ProjectInput `json:"project,omitempty"`
+}
@@ -22,6 +22,8 @@ type Group struct {
 		User User          `json:"user"`
 		Role api.GroupRole `json:"role"`
 	} `json:"members,omitempty"`
+	// Projects are unmarshalled from an allGroups query response.
+	Projects []ProjectInput `json:"projects,omitempty"`
 }
 
 // GroupConfig embeds AddGroupInput as well as a list of members.
@@ -40,6 +42,12 @@ type UserGroupRoleInput struct {
 	GroupRole api.GroupRole `json:"groupRole"`
 }
 
+// UserGroupInput is based on the input to removeUserFromGroup.
+type UserGroupInput struct {
+	UserEmail string `json:"userEmail"`
+	GroupName string `json:"groupName"`
+}
+
 // UserRoleConfig stores a user/role config within a group.
 type UserRoleConfig struct {
 	Email string        `json:"email"`
@@ -123,3 +131,9 @@ func (g *Groups) UnmarshalJSON(b []byte) error {
 	}
 	return nil
 }
+
+// DeleteGroupInput is based on the input to deleteGroup and
+// deleteBillingGroup.
+type DeleteGroupInput struct {
+	Group GroupInput `json:"group"`
+}
@@ -0,0 +1,280 @@
+package schema
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+)
+
+// jsonSchemaID is the URL at which the config JSON Schema is published.
+const jsonSchemaID = "https://amazeeio.github.io/lagoon-cli/config.schema.json"
+
+// enumTypes maps the enum types used in a Config to their validEnums key.
+var enumTypes = map[reflect.Type]string{
+	reflect.TypeOf(Currency("")):             "Currency",
+	reflect.TypeOf(api.DeployType("")):       "DeployType",
+	reflect.TypeOf(api.EnvType("")):          "EnvType",
+	reflect.TypeOf(api.EnvVariableScope("")): "EnvVariableScope",
+	reflect.TypeOf(api.GroupRole("")):        "GroupRole",
+	reflect.TypeOf(api.SSHKeyType("")):       "SshKeyType",
+}
+
+// secretFieldNames maps struct types to the name of their field which may
+// hold a SecretRef.
+var secretFieldNames = map[reflect.Type]string{
+	reflect.TypeOf(EnvKeyValue{}):                        "value",
+	reflect.TypeOf(AddProjectInput{}):                    "privateKey",
+	reflect.TypeOf(AddNotificationSlackInput{}):          "webhook",
+	reflect.TypeOf(AddNotificationRocketChatInput{}):     "webhook",
+	reflect.TypeOf(AddNotificationMicrosoftTeamsInput{}): "webhook",
+}
+
+// enumAliases returns the aliases accepted in config files for the values of
+// the given enum type.
+func enumAliases(enumType string) []string {
+	var aliases []string
+	switch enumType {
+	case "DeployType":
+		for k := range deployTypeAliases {
+			aliases = append(aliases, string(k))
+		}
+	case "EnvType":
+		for k := range envTypeAliases {
+			aliases = append(aliases, string(k))
+		}
+	case "EnvVariableScope":
+		for k := range envVarScopeAliases {
+			aliases = append(aliases, string(k))
+		}
+	case "SshKeyType":
+		for k := range sshKeyTypeAliases {
+			aliases = append(aliases, string(k))
+		}
+	}
+	sort.Strings(aliases)
+	return aliases
+}
+
+// jsonSchema is a JSON Schema object.
+type jsonSchema map[string]interface{}
+
+// schemaGenerator generates JSON Schemas from Go types, following the rules
+// used by encoding/json to (un)marshal them.
+type schemaGenerator struct {
+	definitions map[string]jsonSchema
+	// overrides are used for types with custom (un)marshaling.
+	overrides map[reflect.Type]func() jsonSchema
+}
+
+// JSONSchema returns a JSON Schema describing the config file format used by
+// import and export. It is generated from the Config type.
+func JSONSchema() ([]byte, error) {
+	g := schemaGenerator{definitions: map[string]jsonSchema{}}
+	g.overrides = map[reflect.Type]func() jsonSchema{
+		// NotificationsConfig has custom (un)marshaling
+		reflect.TypeOf(NotificationsConfig{}): func() jsonSchema {
+			return g.object(map[string]jsonSchema{
+				"slack": g.schemaFor(
+					reflect.TypeOf([]AddNotificationSlackInput{})),
+				"rocketChat": g.schemaFor(
+					reflect.TypeOf([]AddNotificationRocketChatInput{})),
+				"email": g.schemaFor(
+					reflect.TypeOf([]AddNotificationEmailInput{})),
+				"microsoftTeams": g.schemaFor(
+					reflect.TypeOf([]AddNotificationMicrosoftTeamsInput{})),
+			})
+		},
+	}
+	// the root is inlined because keywords alongside $ref are ignored
+	root := g.structSchema(reflect.TypeOf(Config{}))
+	root["$schema"] = "http://json-schema.org/draft-07/schema#"
+	root["$id"] = jsonSchemaID
+	root["title"] = "Lagoon CLI config"
+	root["definitions"] = g.definitions
+	return json.MarshalIndent(root, "", "  ")
+}
+
+// schemaFor returns the JSON Schema for the given type. Named struct types
+// are added to the definitions and referred to by $ref.
+func (g *schemaGenerator) schemaFor(t reflect.Type) jsonSchema {
+	if t.Kind() == reflect.Ptr {
+		return g.schemaFor(t.Elem())
+	}
+	if enumType, ok := enumTypes[t]; ok {
+		values := append([]string(nil), validEnums[enumType]...)
+		values = append(values, enumAliases(enumType)...)
+		return jsonSchema{"type": "string", "enum": values}
+	}
+	textMarshaler := reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	if t.Implements(textMarshaler) || reflect.PtrTo(t).Implements(textMarshaler) {
+		return jsonSchema{"type": "string"}
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return jsonSchema{"type": "string"}
+	case reflect.Bool:
+		return jsonSchema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return jsonSchema{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		return jsonSchema{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return jsonSchema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return jsonSchema{"type": "array", "items": g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return jsonSchema{
+			"type":                 "object",
+			"additionalProperties": g.schemaFor(t.Elem()),
+		}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		if _, ok := g.definitions[t.Name()]; !ok {
+			g.definitions[t.Name()] = nil // guard against recursion
+			g.definitions[t.Name()] = g.structSchema(t)
+		}
+		return jsonSchema{"$ref": "#/definitions/" + t.Name()}
+	default:
+		return jsonSchema{}
+	}
+}
+
+// structSchema returns the JSON Schema for a struct type.
+func (g *schemaGenerator) structSchema(t reflect.Type) jsonSchema {
+	if override, ok := g.overrides[t]; ok {
+		return override()
+	}
+	properties := map[string]jsonSchema{}
+	for _, f := range jsonFields(t) {
+		if secretFieldNames[f.owner] == f.name {
+			properties[f.name] = g.secretSchema()
+			continue
+		}
+		properties[f.name] = g.schemaFor(f.typ)
+	}
+	return g.object(properties)
+}
+
+// secretSchema returns the JSON Schema for a string field which may hold a
+// SecretRef.
+func (g *schemaGenerator) secretSchema() jsonSchema {
+	ref := g.schemaFor(reflect.TypeOf(SecretRef{}))
+	secretRef := g.definitions["SecretRef"]
+	secretRef["minProperties"] = 1
+	secretRef["maxProperties"] = 1
+	return jsonSchema{"oneOf": []jsonSchema{{"type": "string"}, ref}}
+}
+
+// object returns the JSON Schema for an object with the given properties.
+func (g *schemaGenerator) object(properties map[string]jsonSchema) jsonSchema {
+	return jsonSchema{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+// jsonField is a struct field as seen by encoding/json.
+type jsonField struct {
+	name string
+	typ  reflect.Type
+	// owner is the struct type which declares the field.
+	owner  reflect.Type
+	depth  int
+	tagged bool
+}
+
+// jsonFields returns the fields of a struct type which encoding/json
+// (un)marshals, sorted by name. Fields of embedded structs are promoted, and
+// conflicting names are resolved in the same way as encoding/json: the
+// shallowest field wins, then the tagged field, and otherwise all fields with
+// that name are ignored.
+func jsonFields(t reflect.Type) []jsonField {
+	var candidates []jsonField
+	var walk func(reflect.Type, int)
+	walk = func(t reflect.Type, depth int) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name := strings.Split(tag, ",")[0]
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+				walk(ft, depth+1)
+				continue
+			}
+			if f.PkgPath != "" {
+				continue // unexported
+			}
+			candidates = append(candidates, jsonField{
+				name:   name,
+				typ:    f.Type,
+				owner:  t,
+				depth:  depth,
+				tagged: name != "",
+			})
+			if name == "" {
+				candidates[len(candidates)-1].name = f.Name
+			}
+		}
+	}
+	walk(t, 0)
+
+	byName := map[string][]jsonField{}
+	var names []string
+	for _, f := range candidates {
+		if _, ok := byName[f.name]; !ok {
+			names = append(names, f.name)
+		}
+		byName[f.name] = append(byName[f.name], f)
+	}
+	sort.Strings(names)
+	var fields []jsonField
+	for _, name := range names {
+		if f, ok := dominantField(byName[name]); ok {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// dominantField returns the field which encoding/json uses out of fields with
+// the same name.
+func dominantField(fields []jsonField) (jsonField, bool) {
+	minDepth := fields[0].depth
+	for _, f := range fields {
+		if f.depth < minDepth {
+			minDepth = f.depth
+		}
+	}
+	var shallowest, tagged []jsonField
+	for _, f := range fields {
+		if f.depth != minDepth {
+			continue
+		}
+		shallowest = append(shallowest, f)
+		if f.tagged {
+			tagged = append(tagged, f)
+		}
+	}
+	switch {
+	case len(shallowest) == 1:
+		return shallowest[0], true
+	case len(tagged) == 1:
+		return tagged[0], true
+	default:
+		return jsonField{}, false
+	}
+}
@@ -0,0 +1,95 @@
+package schema_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+// TestJSONSchema checks that the published JSON Schema is in sync with the
+// Config type. Run with -update to regenerate it.
+func TestJSONSchema(t *testing.T) {
+	expect := "../../docs/config.schema.json"
+	result, err := schema.JSONSchema()
+	if err != nil {
+		t.Fatalf("couldn't generate schema: %v", err)
+	}
+	result = append(result, '\n')
+
+	if *update {
+		t.Logf("update golden file: %s", expect)
+		if err = ioutil.WriteFile(expect, result, 0644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+
+	expected, err := ioutil.ReadFile(expect)
+	if err != nil {
+		t.Fatalf("failed reading golden file: %v", err)
+	}
+	if !bytes.Equal(result, expected) {
+		t.Errorf("%s is out of date, regenerate it with go test -update", expect)
+	}
+}
+
+// TestJSONSchemaProperties checks that the schema follows the json tags of
+// embedded and overridden fields.
+func TestJSONSchemaProperties(t *testing.T) {
+	data, err := schema.JSONSchema()
+	if err != nil {
+		t.Fatalf("couldn't generate schema: %v", err)
+	}
+	var s struct {
+		Definitions map[string]struct {
+			Properties map[string]json.RawMessage `json:"properties"`
+		} `json:"definitions"`
+	}
+	if err = json.Unmarshal(data, &s); err != nil {
+		t.Fatalf("couldn't unmarshal schema: %v", err)
+	}
+	var testCases = map[string]struct {
+		definition string
+		property   string
+		expect     string
+	}{
+		"project groups": {
+			definition: "ProjectConfig",
+			property:   "groups",
+			expect:     `{"items":{"type":"string"},"type":"array"}`,
+		},
+		"embedded project name": {
+			definition: "ProjectConfig",
+			property:   "name",
+			expect:     `{"type":"string"}`,
+		},
+		"environment project": {
+			definition: "EnvironmentConfig",
+			property:   "project",
+			expect:     `{"minimum":0,"type":"integer"}`,
+		},
+		"notifications": {
+			definition: "NotificationsConfig",
+			property:   "slack",
+			expect: `{"items":{"$ref":"#/definitions/AddNotificationSlackInput"},` +
+				`"type":"array"}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			raw, ok := s.Definitions[tc.definition].Properties[tc.property]
+			if !ok {
+				tt.Fatalf("missing property %s.%s", tc.definition, tc.property)
+			}
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, raw); err != nil {
+				tt.Fatalf("couldn't compact property: %v", err)
+			}
+			if buf.String() != tc.expect {
+				tt.Fatalf("expected %s, got %s", tc.expect, buf.String())
+			}
+		})
+	}
+}
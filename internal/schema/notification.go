@@ -55,6 +55,61 @@ type NotificationMicrosoftTeams struct {
 	ID uint `json:"id,omitempty"`
 }
 
+// ProjectRocketChats is based on a query for the RocketChat notifications of
+// a project.
+type ProjectRocketChats struct {
+	Name        string                   `json:"name"`
+	RocketChats []NotificationRocketChat `json:"rocketChats"`
+}
+
+// ProjectSlacks is based on a query for the Slack notifications of a project.
+type ProjectSlacks struct {
+	Name   string              `json:"name"`
+	Slacks []NotificationSlack `json:"slacks"`
+}
+
+// UpdateNotificationRocketChatInput is based on the input to
+// updateNotificationRocketChat.
+type UpdateNotificationRocketChatInput struct {
+	Name  string                                 `json:"name"`
+	Patch UpdateNotificationRocketChatPatchInput `json:"patch"`
+}
+
+// UpdateNotificationRocketChatPatchInput is based on the Lagoon API type.
+type UpdateNotificationRocketChatPatchInput struct {
+	Name    string `json:"name,omitempty"`
+	Webhook string `json:"webhook,omitempty"`
+	Channel string `json:"channel,omitempty"`
+}
+
+// UpdateNotificationSlackInput is based on the input to
+// updateNotificationSlack.
+type UpdateNotificationSlackInput struct {
+	Name  string                            `json:"name"`
+	Patch UpdateNotificationSlackPatchInput `json:"patch"`
+}
+
+// UpdateNotificationSlackPatchInput is based on the Lagoon API type.
+type UpdateNotificationSlackPatchInput struct {
+	Name    string `json:"name,omitempty"`
+	Webhook string `json:"webhook,omitempty"`
+	Channel string `json:"channel,omitempty"`
+}
+
+// UpdateNotificationEmailInput is based on the input to
+// updateNotificationEmail.
+type UpdateNotificationEmailInput struct {
+	Name  string                    `json:"name"`
+	Patch AddNotificationEmailInput `json:"patch"`
+}
+
+// UpdateNotificationMicrosoftTeamsInput is based on the input to
+// updateNotificationMicrosoftTeams.
+type UpdateNotificationMicrosoftTeamsInput struct {
+	Name  string                             `json:"name"`
+	Patch AddNotificationMicrosoftTeamsInput `json:"patch"`
+}
+
 // Notifications represents possible Lagoon notification types.
 // These are unmarshalled from a projectByName query response.
 type Notifications struct {
@@ -200,3 +255,10 @@ func (n *NotificationsConfig) UnmarshalJSON(b []byte) error {
 	}
 	return nil
 }
+
+// DeleteNotificationInput is based on the input to deleteNotificationSlack,
+// deleteNotificationRocketChat, deleteNotificationEmail, and
+// deleteNotificationMicrosoftTeams.
+type DeleteNotificationInput struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,123 @@
+package schema
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// yamlKey matches a block mapping key at the start of a line. The key may be
+// quoted.
+var yamlKey = regexp.MustCompile(`^("[^"]*"|'[^']*'|[^\s#'"{\[][^:#]*?)\s*:(\s|$)`)
+
+// Position is a location in a YAML file. Line and Column start at 1.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// positionIndex maps paths in a YAML document, such as
+// projects[0].envVariables[1].scope, to the position of the corresponding key
+// or sequence item.
+type positionIndex map[string]Position
+
+// indexFrame is a block mapping or sequence being indexed.
+type indexFrame struct {
+	indent int
+	path   string
+	seq    bool
+	item   int
+}
+
+// indexPositions builds a positionIndex of the block-style mappings and
+// sequences in a YAML document. Flow-style collections are not indexed, so
+// lookups of paths within them resolve to the enclosing key.
+func indexPositions(data []byte) positionIndex {
+	index := positionIndex{}
+	stack := []*indexFrame{{indent: -1}}
+	var pending string // path of the last key without an inline value
+	blockScalar := -1  // indent of the key owning a block scalar
+	s := bufio.NewScanner(bytes.NewReader(data))
+	s.Buffer(nil, 1024*1024)
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimRight(s.Text(), " \t\r")
+		trimmed := strings.TrimLeft(text, " ")
+		col := len(text) - len(trimmed)
+		if blockScalar >= 0 {
+			if trimmed == "" || col > blockScalar {
+				continue // block scalar content
+			}
+			blockScalar = -1
+		}
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") ||
+			trimmed == "---" || trimmed == "..." {
+			continue
+		}
+		for trimmed != "" {
+			for len(stack) > 1 && stack[len(stack)-1].indent > col {
+				stack = stack[:len(stack)-1]
+			}
+			top := stack[len(stack)-1]
+			if trimmed == "-" || strings.HasPrefix(trimmed, "- ") {
+				// sequence item
+				if !top.seq || top.indent != col {
+					top = &indexFrame{indent: col, path: pending, seq: true, item: -1}
+					stack = append(stack, top)
+				}
+				top.item++
+				pending = fmt.Sprintf("%s[%d]", top.path, top.item)
+				index[pending] = Position{Line: line, Column: col + 1}
+				rest := strings.TrimLeft(strings.TrimPrefix(trimmed, "-"), " ")
+				col += len(trimmed) - len(rest)
+				trimmed = rest
+				continue
+			}
+			match := yamlKey.FindStringSubmatch(trimmed)
+			if match == nil {
+				break // scalar or flow collection
+			}
+			// a key ends any sequence at the same indent
+			for len(stack) > 1 && top.seq && top.indent == col {
+				stack = stack[:len(stack)-1]
+				top = stack[len(stack)-1]
+			}
+			if top.seq || top.indent != col {
+				top = &indexFrame{indent: col, path: pending}
+				stack = append(stack, top)
+			}
+			key := strings.Trim(match[1], `"'`)
+			path := key
+			if top.path != "" {
+				path = top.path + "." + key
+			}
+			index[path] = Position{Line: line, Column: col + 1}
+			value := strings.TrimSpace(trimmed[len(match[0]):])
+			switch {
+			case value == "" || strings.HasPrefix(value, "#"):
+				pending = path
+			case strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">"):
+				blockScalar = col
+			}
+			break
+		}
+	}
+	return index
+}
+
+// lookup returns the position of the given path, or of its closest indexed
+// ancestor.
+func (index positionIndex) lookup(path string) (Position, bool) {
+	for path != "" {
+		if pos, ok := index[path]; ok {
+			return pos, true
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			break
+		}
+		path = path[:i]
+	}
+	return Position{}, false
+}
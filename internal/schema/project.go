@@ -95,3 +95,41 @@ type ProjectBillingGroupInput struct {
 	Group   GroupInput   `json:"group"`
 	Project ProjectInput `json:"project"`
 }
+
+// UpdateProjectPatchInput is based on the Lagoon API type.
+type UpdateProjectPatchInput struct {
+	Name                         string              `json:"name,omitempty"`
+	GitURL                       string              `json:"gitUrl,omitempty"`
+	Subfolder                    string              `json:"subfolder,omitempty"`
+	Openshift                    uint                `json:"openshift,omitempty"`
+	OpenshiftProjectPattern      string              `json:"openshiftProjectPattern,omitempty"`
+	ActiveSystemsDeploy          string              `json:"activeSystemsDeploy,omitempty"`
+	ActiveSystemsPromote         string              `json:"activeSystemsPromote,omitempty"`
+	ActiveSystemsRemove          string              `json:"activeSystemsRemove,omitempty"`
+	ActiveSystemsTask            string              `json:"activeSystemsTask,omitempty"`
+	Branches                     string              `json:"branches,omitempty"`
+	PullRequests                 string              `json:"pullrequests,omitempty"`
+	ProductionEnvironment        string              `json:"productionEnvironment,omitempty"`
+	Availability                 ProjectAvailability `json:"availability,omitempty"`
+	DevelopmentEnvironmentsLimit uint                `json:"developmentEnvironmentsLimit,omitempty"`
+	PrivateKey                   string              `json:"privateKey,omitempty"`
+	// AutoIdle and StorageCalc are pointers because their zero-values are
+	// significant, but they should be omitted from the patch if unchanged.
+	AutoIdle    *uint `json:"autoIdle,omitempty"`
+	StorageCalc *uint `json:"storageCalc,omitempty"`
+}
+
+// UpdateProjectInput is based on the input to updateProject.
+type UpdateProjectInput struct {
+	ID    uint                    `json:"id"`
+	Patch UpdateProjectPatchInput `json:"patch"`
+}
+
+// RemoveNotificationFromProjectInput is based on the input to
+// removeNotificationFromProject.
+type RemoveNotificationFromProjectInput AddNotificationToProjectInput
+
+// DeleteProjectInput is based on the input to deleteProject.
+type DeleteProjectInput struct {
+	Project string `json:"project"`
+}
@@ -0,0 +1,354 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// secretRefPrefix marks a string field in a Config which holds an unresolved
+// SecretRef rather than a plaintext value. It starts with a NUL byte so that
+// it can't clash with real values. Values which start with it are rejected
+// in config files and escaped when read from the Lagoon API.
+const secretRefPrefix = "\x00lagoon-secret:"
+
+// SecretRef is a reference to a secret value in a config file, such as
+//
+//	value: {fromEnv: DB_PASS}
+//
+// Exactly one of the fields is set. Secret references are allowed in project
+// and environment envVariables values, project privateKeys, and notification
+// webhooks.
+type SecretRef struct {
+	// FromEnv is the name of an environment variable.
+	FromEnv string `json:"fromEnv,omitempty"`
+	// FromFile is the path of a file.
+	FromFile string `json:"fromFile,omitempty"`
+	// FromCommand is a shell command which prints the secret.
+	FromCommand string `json:"fromCommand,omitempty"`
+}
+
+// String implements fmt.Stringer.
+func (r SecretRef) String() string {
+	switch {
+	case r.FromEnv != "":
+		return "fromEnv " + r.FromEnv
+	case r.FromFile != "":
+		return "fromFile " + r.FromFile
+	default:
+		return "fromCommand " + r.FromCommand
+	}
+}
+
+// encode returns the SecretRef encoded as a string field value.
+func (r SecretRef) encode() string {
+	data, _ := json.Marshal(r) // can't fail
+	return secretRefPrefix + string(data)
+}
+
+// decodeSecretRef returns the SecretRef encoded in s, if any.
+func decodeSecretRef(s string) (SecretRef, bool) {
+	ref := SecretRef{}
+	if !strings.HasPrefix(s, secretRefPrefix) {
+		return ref, false
+	}
+	err := json.Unmarshal([]byte(strings.TrimPrefix(s, secretRefPrefix)), &ref)
+	return ref, err == nil
+}
+
+// parseSecretRef parses a secret reference object in a config file.
+func parseSecretRef(m map[string]interface{}) (SecretRef, error) {
+	ref := SecretRef{}
+	if len(m) != 1 {
+		return ref, fmt.Errorf("secret reference must have exactly one of " +
+			"fromEnv, fromFile or fromCommand")
+	}
+	for k, v := range m {
+		s, ok := v.(string)
+		if !ok || s == "" {
+			return ref, fmt.Errorf("%s must be a non-empty string", k)
+		}
+		switch k {
+		case "fromEnv":
+			ref.FromEnv = s
+		case "fromFile":
+			ref.FromFile = s
+		case "fromCommand":
+			ref.FromCommand = s
+		default:
+			return ref, fmt.Errorf("unknown secret reference %s, must be one of "+
+				"fromEnv, fromFile or fromCommand", k)
+		}
+	}
+	return ref, nil
+}
+
+// objects returns the items of a list in a generic config as maps. Items
+// which aren't maps are returned as nil so that indexes are preserved.
+func objects(v interface{}) []map[string]interface{} {
+	items, _ := v.([]interface{})
+	objs := make([]map[string]interface{}, len(items))
+	for i := range items {
+		objs[i], _ = items[i].(map[string]interface{})
+	}
+	return objs
+}
+
+// visitSecretRefFields calls visit for each field of a generic config which
+// may hold a secret reference, with the path of its object and its key.
+func visitSecretRefFields(config map[string]interface{},
+	visit func(path string, m map[string]interface{}, key string) error) error {
+	envVariables := func(path string, evs interface{}) error {
+		for i, ev := range objects(evs) {
+			err := visit(fmt.Sprintf("%s.envVariables[%d]", path, i), ev, "value")
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for i, p := range objects(config["projects"]) {
+		path := fmt.Sprintf("projects[%d]", i)
+		if err := visit(path, p, "privateKey"); err != nil {
+			return err
+		}
+		if err := envVariables(path, p["envVariables"]); err != nil {
+			return err
+		}
+		for j, env := range objects(p["environments"]) {
+			err := envVariables(fmt.Sprintf("%s.environments[%d]", path, j),
+				env["envVariables"])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	notifications, _ := config["notifications"].(map[string]interface{})
+	for _, nType := range []string{"slack", "rocketChat", "microsoftTeams"} {
+		for i, n := range objects(notifications[nType]) {
+			err := visit(fmt.Sprintf("notifications.%s[%d]", nType, i), n, "webhook")
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// encodeSecretRefs replaces the secret reference objects in the secret fields
+// of a generic config with encoded SecretRefs, so that the config can be
+// unmarshaled into a Config. Plain values which look like encoded SecretRefs
+// are rejected, so that only secret reference objects are resolved.
+func encodeSecretRefs(config map[string]interface{}) error {
+	return visitSecretRefFields(config,
+		func(path string, m map[string]interface{}, key string) error {
+			switch v := m[key].(type) {
+			case string:
+				if strings.HasPrefix(v, secretRefPrefix) {
+					return fmt.Errorf("%s.%s: invalid value", path, key)
+				}
+			case map[string]interface{}:
+				ref, err := parseSecretRef(v)
+				if err != nil {
+					return fmt.Errorf("%s.%s: %v", path, key, err)
+				}
+				m[key] = ref.encode()
+			}
+			return nil
+		})
+}
+
+// decodeSecretRefs replaces the encoded SecretRefs in the secret fields of a
+// generic config with secret reference objects, so that they are marshaled as
+// such.
+func decodeSecretRefs(config map[string]interface{}) {
+	visitSecretRefFields(config, // visit never fails
+		func(_ string, m map[string]interface{}, key string) error {
+			if s, ok := m[key].(string); ok {
+				if ref, ok := decodeSecretRef(s); ok {
+					m[key] = ref
+				}
+			}
+			return nil
+		})
+}
+
+// unmarshalSecretRefs is applied to JSON config data before it is unmarshaled
+// into a Config. See encodeSecretRefs.
+func unmarshalSecretRefs(data []byte) ([]byte, error) {
+	var config map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&config); err != nil {
+		return nil, err
+	}
+	if err := encodeSecretRefs(config); err != nil {
+		return nil, err
+	}
+	return json.Marshal(config)
+}
+
+// marshalSecretRefs is applied to JSON config data after it is marshaled from
+// a Config. See decodeSecretRefs.
+func marshalSecretRefs(data []byte) ([]byte, error) {
+	if !bytes.Contains(data, []byte(`\u0000lagoon-secret:`)) {
+		return data, nil
+	}
+	var config map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&config); err != nil {
+		return nil, err
+	}
+	decodeSecretRefs(config)
+	return json.Marshal(config)
+}
+
+// secretField is a field in a Config which may hold a secret.
+type secretField struct {
+	// path identifies the field, as in ValidationError.
+	path string
+	// name is a human-readable name used to generate environment variable
+	// names for the field.
+	name []string
+	// value points to the field.
+	value *string
+}
+
+// secretFields returns the fields in the config which may hold secrets.
+func (c *Config) secretFields() []secretField {
+	var fields []secretField
+	envVariables := func(path string, name []string, evs []EnvKeyValue) {
+		for i := range evs {
+			fields = append(fields, secretField{
+				path:  fmt.Sprintf("%s.envVariables[%d].value", path, i),
+				name:  append(append([]string{}, name...), evs[i].Name),
+				value: &evs[i].Value,
+			})
+		}
+	}
+	for i := range c.Projects {
+		p := &c.Projects[i]
+		path := fmt.Sprintf("projects[%d]", i)
+		fields = append(fields, secretField{
+			path:  path + ".privateKey",
+			name:  []string{p.Name, "private_key"},
+			value: &p.PrivateKey,
+		})
+		envVariables(path, []string{p.Name}, p.EnvVariables)
+		for j := range p.Environments {
+			env := &p.Environments[j]
+			envVariables(fmt.Sprintf("%s.environments[%d]", path, j),
+				[]string{p.Name, env.Name}, env.EnvVariables)
+		}
+	}
+	if c.Notifications == nil {
+		return fields
+	}
+	webhook := func(nType string, i int, name string, value *string) {
+		fields = append(fields, secretField{
+			path:  fmt.Sprintf("notifications.%s[%d].webhook", nType, i),
+			name:  []string{nType, name, "webhook"},
+			value: value,
+		})
+	}
+	for i := range c.Notifications.Slack {
+		n := &c.Notifications.Slack[i]
+		webhook("slack", i, n.Name, &n.Webhook)
+	}
+	for i := range c.Notifications.RocketChat {
+		n := &c.Notifications.RocketChat[i]
+		webhook("rocketChat", i, n.Name, &n.Webhook)
+	}
+	for i := range c.Notifications.MicrosoftTeams {
+		n := &c.Notifications.MicrosoftTeams[i]
+		webhook("microsoftTeams", i, n.Name, &n.Webhook)
+	}
+	return fields
+}
+
+// escapeSecretRefs removes the leading NUL byte from secret values which look
+// like encoded SecretRefs, so that values read from the Lagoon API are never
+// turned into secret references.
+func (c *Config) escapeSecretRefs() {
+	for _, f := range c.secretFields() {
+		if strings.HasPrefix(*f.value, secretRefPrefix) {
+			*f.value = strings.TrimPrefix(*f.value, "\x00")
+		}
+	}
+}
+
+// ResolveSecrets replaces the secret references in the config with the
+// values returned by resolve.
+func (c *Config) ResolveSecrets(resolve func(SecretRef) (string, error)) error {
+	for _, f := range c.secretFields() {
+		ref, ok := decodeSecretRef(*f.value)
+		if !ok {
+			continue
+		}
+		value, err := resolve(ref)
+		if err != nil {
+			return fmt.Errorf("couldn't resolve secret %s for %s: %w",
+				ref, f.path, err)
+		}
+		*f.value = value
+	}
+	return nil
+}
+
+// RedactSecrets replaces the secret values in the config with fromEnv secret
+// references, and returns the values by environment variable name. The names
+// are prefixed with LAGOON_SECRET_ and derived from the field, for example
+// LAGOON_SECRET_MYPROJECT_MASTER_DB_PASS for the DB_PASS variable of the
+// master environment of myproject. Empty values are left in place.
+func (c *Config) RedactSecrets() map[string]string {
+	secrets := map[string]string{}
+	for _, f := range c.secretFields() {
+		if *f.value == "" {
+			continue
+		}
+		if _, ok := decodeSecretRef(*f.value); ok {
+			continue
+		}
+		base := secretEnvName(f.name)
+		name := base
+		for n := 2; ; n++ {
+			if _, ok := secrets[name]; !ok {
+				break
+			}
+			name = fmt.Sprintf("%s_%d", base, n)
+		}
+		secrets[name] = *f.value
+		*f.value = SecretRef{FromEnv: name}.encode()
+	}
+	return secrets
+}
+
+// MaskSecrets replaces each non-empty secret value in the config with the
+// value returned by mask. mask is given a name which identifies the field
+// across configs, such as myproject/master/DB_PASS, and the secret value.
+func (c *Config) MaskSecrets(mask func(name, value string) string) {
+	for _, f := range c.secretFields() {
+		if *f.value == "" {
+			continue
+		}
+		*f.value = mask(strings.Join(f.name, "/"), *f.value)
+	}
+}
+
+// secretEnvName returns an environment variable name for the given name
+// parts.
+func secretEnvName(parts []string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		default:
+			return '_'
+		}
+	}, strings.Join(parts, "_"))
+	return "LAGOON_SECRET_" + name
+}
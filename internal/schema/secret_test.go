@@ -0,0 +1,225 @@
+package schema_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+func TestResolveSecrets(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/secrets.config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := schema.Config{}
+	if err = schema.UnmarshalConfigYAML(data, &config); err != nil {
+		t.Fatalf("couldn't unmarshal config: %v", err)
+	}
+	var resolved []string
+	err = config.ResolveSecrets(func(ref schema.SecretRef) (string, error) {
+		resolved = append(resolved, ref.String())
+		return "secret " + ref.String(), nil
+	})
+	if err != nil {
+		t.Fatalf("couldn't resolve secrets: %v", err)
+	}
+	expectResolved := []string{
+		"fromFile ./secrets/foo-bar.key",
+		"fromEnv DB_PASS",
+		"fromCommand pass show api-key",
+		"fromEnv SLACK_WEBHOOK",
+	}
+	if !reflect.DeepEqual(resolved, expectResolved) {
+		t.Fatalf("expected %v, got %v", expectResolved, resolved)
+	}
+	p := config.Projects[0]
+	env := p.Environments[0]
+	for _, v := range []struct{ result, expect string }{
+		{p.PrivateKey, "secret fromFile ./secrets/foo-bar.key"},
+		{p.EnvVariables[0].Value, "secret fromEnv DB_PASS"},
+		{p.EnvVariables[1].Value, "true"},
+		{env.EnvVariables[0].Value, "secret fromCommand pass show api-key"},
+		{string(env.EnvVariables[0].Scope), "GLOBAL"},
+		{config.Notifications.Slack[0].Webhook, "secret fromEnv SLACK_WEBHOOK"},
+	} {
+		if v.result != v.expect {
+			t.Errorf("expected %q, got %q", v.expect, v.result)
+		}
+	}
+}
+
+func TestResolveSecretsError(t *testing.T) {
+	config := schema.Config{}
+	err := schema.UnmarshalConfigYAML(
+		[]byte("projects:\n- name: foo\n  privateKey: {fromEnv: KEY}\n"), &config)
+	if err != nil {
+		t.Fatalf("couldn't unmarshal config: %v", err)
+	}
+	err = config.ResolveSecrets(func(schema.SecretRef) (string, error) {
+		return "", fmt.Errorf("not set")
+	})
+	expect := "couldn't resolve secret fromEnv KEY for projects[0].privateKey: " +
+		"not set"
+	if err == nil || err.Error() != expect {
+		t.Fatalf("expected error %q, got %v", expect, err)
+	}
+}
+
+func TestInvalidSecretRefs(t *testing.T) {
+	var testCases = map[string]struct {
+		input     string
+		expectErr string
+	}{
+		"two references": {
+			input:     "projects:\n- privateKey: {fromEnv: A, fromFile: b}\n",
+			expectErr: "projects[0].privateKey: secret reference must have exactly one",
+		},
+		"unknown reference": {
+			input: "notifications:\n  slack:\n  - webhook: {fromVault: a}\n",
+			expectErr: "notifications.slack[0].webhook: unknown secret reference " +
+				"fromVault",
+		},
+		"empty reference": {
+			input: "projects:\n- environments:\n  - envVariables:\n" +
+				"    - value: {fromEnv: \"\"}\n",
+			expectErr: "projects[0].environments[0].envVariables[0].value: " +
+				"fromEnv must be a non-empty string",
+		},
+		"encoded reference": {
+			input:     "projects:\n- privateKey: \"\\0lagoon-secret:{}\"\n",
+			expectErr: "projects[0].privateKey: invalid value",
+		},
+		"not a secret field": {
+			input:     "projects:\n- gitUrl: {fromEnv: A}\n",
+			expectErr: "cannot unmarshal object",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			config := schema.Config{}
+			err := schema.UnmarshalConfigYAML([]byte(tc.input), &config)
+			if err == nil || !strings.Contains(err.Error(), tc.expectErr) {
+				tt.Fatalf("expected error containing %q, got %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestRedactSecrets(t *testing.T) {
+	config := schema.Config{}
+	err := schema.UnmarshalConfigYAML([]byte(`projects:
+- name: foo-bar
+  privateKey: "-----BEGIN KEY-----"
+  envVariables:
+  - name: DB_PASS
+    scope: runtime
+    value: hunter2
+  - name: EMPTY
+    scope: runtime
+    value: ""
+  environments:
+  - name: master
+    envVariables:
+    - name: DB_PASS
+      scope: runtime
+      value: hunter3
+- name: foo.bar
+  envVariables:
+  - name: DB_PASS
+    scope: runtime
+    value: hunter4
+notifications:
+  slack:
+  - name: foo-slack
+    channel: foo
+    webhook: https://hooks.example.com/foo
+`), &config)
+	if err != nil {
+		t.Fatalf("couldn't unmarshal config: %v", err)
+	}
+	secrets := config.RedactSecrets()
+	expectSecrets := map[string]string{
+		"LAGOON_SECRET_FOO_BAR_PRIVATE_KEY":     "-----BEGIN KEY-----",
+		"LAGOON_SECRET_FOO_BAR_DB_PASS":         "hunter2",
+		"LAGOON_SECRET_FOO_BAR_MASTER_DB_PASS":  "hunter3",
+		"LAGOON_SECRET_FOO_BAR_DB_PASS_2":       "hunter4",
+		"LAGOON_SECRET_SLACK_FOO_SLACK_WEBHOOK": "https://hooks.example.com/foo",
+	}
+	if !reflect.DeepEqual(secrets, expectSecrets) {
+		t.Fatalf("expected secrets %v, got %v", expectSecrets, secrets)
+	}
+	result, err := schema.MarshalConfigYAML(&config, nil)
+	if err != nil {
+		t.Fatalf("couldn't marshal config: %v", err)
+	}
+	for _, expect := range []string{
+		"privateKey:\n    fromEnv: LAGOON_SECRET_FOO_BAR_PRIVATE_KEY\n",
+		"value:\n      fromEnv: LAGOON_SECRET_FOO_BAR_DB_PASS\n",
+		"value: \"\"\n",
+		"webhook:\n      fromEnv: LAGOON_SECRET_SLACK_FOO_SLACK_WEBHOOK\n",
+	} {
+		if !bytes.Contains(result, []byte(expect)) {
+			t.Errorf("expected %q in result:\n%s", expect, result)
+		}
+	}
+	// the redacted config resolves to the original values
+	redacted := schema.Config{}
+	if err = schema.UnmarshalConfigYAML(result, &redacted); err != nil {
+		t.Fatalf("couldn't unmarshal redacted config: %v", err)
+	}
+	err = redacted.ResolveSecrets(func(ref schema.SecretRef) (string, error) {
+		return secrets[ref.FromEnv], nil
+	})
+	if err != nil {
+		t.Fatalf("couldn't resolve secrets: %v", err)
+	}
+	if v := redacted.Projects[1].EnvVariables[0].Value; v != "hunter4" {
+		t.Errorf("expected hunter4, got %q", v)
+	}
+	if v := redacted.Notifications.Slack[0].Webhook; v !=
+		"https://hooks.example.com/foo" {
+		t.Errorf("expected webhook, got %q", v)
+	}
+}
+
+func TestSecretRefsFromAPI(t *testing.T) {
+	// a value set through the API which looks like an encoded secret reference
+	marker := `\u0000lagoon-secret:{\"fromCommand\":\"id\"}`
+	data := schema.ProjectByNameResponse{}
+	err := schema.UnmarshalProjectByNameResponse([]byte(`{"data":{"projectByName":{
+		"name": "foo",
+		"envVariables": [{"name": "X", "scope": "runtime", "value": "`+marker+`"}],
+		"groups": [],
+		"notifications": []
+	}}}`), &data)
+	if err != nil {
+		t.Fatalf("couldn't unmarshal project: %v", err)
+	}
+	result, err := schema.ProjectsToConfig(
+		[]schema.Project{*data.Response.Project}, nil)
+	if err != nil {
+		t.Fatalf("couldn't translate project: %v", err)
+	}
+	if bytes.Contains(result, []byte("fromCommand: id")) {
+		t.Fatalf("expected no secret reference in result:\n%s", result)
+	}
+	config := schema.Config{}
+	if err = schema.UnmarshalConfigYAML(result, &config); err != nil {
+		t.Fatalf("couldn't unmarshal config: %v", err)
+	}
+	err = config.ResolveSecrets(func(ref schema.SecretRef) (string, error) {
+		return "", fmt.Errorf("unexpected secret reference %s", ref)
+	})
+	if err != nil {
+		t.Fatalf("couldn't resolve secrets: %v", err)
+	}
+	expect := `lagoon-secret:{"fromCommand":"id"}`
+	if v := config.Projects[0].EnvVariables[0].Value; v != expect {
+		t.Fatalf("expected value %q, got %q", expect, v)
+	}
+}
@@ -14,3 +14,8 @@ type AddSSHKeyInput struct {
 	SSHKey
 	UserEmail string `json:"userEmail"`
 }
+
+// DeleteSSHKeyInput is based on the input to deleteSshKey.
+type DeleteSSHKeyInput struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,47 @@
+package schema
+
+import "github.com/amazeeio/lagoon-cli/pkg/api"
+
+// Task is the Lagoon API Task object.
+type Task struct {
+	ID       uint               `json:"id"`
+	Name     string             `json:"name"`
+	Status   api.TaskStatusType `json:"status"`
+	RemoteID string             `json:"remoteId"`
+	Service  string             `json:"service"`
+	// TODO use a unixtime type
+	Created   string `json:"created"`
+	Started   string `json:"started"`
+	Completed string `json:"completed"`
+	Logs      string `json:"logs,omitempty"`
+	Files     []File `json:"files,omitempty"`
+}
+
+// File is the Lagoon API File object, a file attached to a task.
+type File struct {
+	ID       uint   `json:"id"`
+	Filename string `json:"filename"`
+	Download string `json:"download"`
+	Created  string `json:"created"`
+}
+
+// AddTaskInput is based on the input to addTask.
+type AddTaskInput struct {
+	Name        string `json:"name"`
+	Environment uint   `json:"environment"`
+	Service     string `json:"service"`
+	Command     string `json:"command"`
+	Execute     bool   `json:"execute"`
+}
+
+// TaskInput is based on the Lagoon API type.
+type TaskInput struct {
+	ID          uint              `json:"id,omitempty"`
+	TaskName    string            `json:"taskName,omitempty"`
+	Environment *EnvironmentInput `json:"environment,omitempty"`
+}
+
+// CancelTaskInput is based on the input to cancelTask.
+type CancelTaskInput struct {
+	Task TaskInput `json:"task"`
+}
@@ -17,3 +17,28 @@ type User struct {
 	ID      *uuid.UUID `json:"id,omitempty"`
 	SSHKeys []SSHKey   `json:"sshKeys,omitempty"`
 }
+
+// UserInput is based on the Lagoon API type.
+type UserInput struct {
+	Email string `json:"email"`
+}
+
+// UpdateUserPatchInput is based on the Lagoon API type.
+type UpdateUserPatchInput struct {
+	Email     string `json:"email,omitempty"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+	Comment   string `json:"comment,omitempty"`
+	GitlabID  uint   `json:"gitlabId,omitempty"`
+}
+
+// UpdateUserInput is based on the input to updateUser.
+type UpdateUserInput struct {
+	User  UserInput            `json:"user"`
+	Patch UpdateUserPatchInput `json:"patch"`
+}
+
+// DeleteUserInput is based on the input to deleteUser.
+type DeleteUserInput struct {
+	User UserInput `json:"user"`
+}
@@ -0,0 +1,300 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/amazeeio/lagoon-cli/pkg/api"
+)
+
+// ValidationError is a problem found in a Config.
+type ValidationError struct {
+	// Path identifies the invalid field, e.g. projects[0].envVariables[1].scope.
+	Path string
+	// Position is the location of the field in the config file, if known.
+	Position Position
+	Message  string
+	// Warning is set if the problem doesn't prevent the config from being
+	// imported, such as a reference to an object which isn't declared in the
+	// config but may exist in Lagoon already.
+	Warning bool
+}
+
+// Error implements the error interface.
+func (e ValidationError) Error() string {
+	msg := e.Message
+	if e.Warning {
+		msg = "warning: " + msg
+	}
+	if e.Position.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.Path, msg)
+	}
+	return fmt.Sprintf("line %d, column %d: %s: %s",
+		e.Position.Line, e.Position.Column, e.Path, msg)
+}
+
+// ValidationErrors is a list of problems found in a Config.
+type ValidationErrors []ValidationError
+
+// Error implements the error interface.
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i := range e {
+		msgs[i] = e[i].Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// validEnums are the allowed values of the enum fields in a Config.
+var validEnums = map[string][]string{
+	"Currency": {string(AUD), string(EUR), string(GBP), string(USD),
+		string(CHF), string(ZAR)},
+	"DeployType": {string(api.Branch), string(api.PullRequest),
+		string(api.Promote)},
+	"EnvType": {string(api.ProductionEnv), string(api.DevelopmentEnv)},
+	"EnvVariableScope": {string(api.BuildVar), string(api.RuntimeVar),
+		string(api.GlobalVar), string(api.InternalContainerRegistryVar),
+		string(api.ContainerRegistryVar)},
+	"GroupRole": {string(api.GuestRole), string(api.ReporterRole),
+		string(api.DeveloperRole), string(api.MaintainerRole),
+		string(api.OwnerRole)},
+	"SshKeyType": {string(api.SSHRsa), string(api.SSHEd25519)},
+}
+
+// validator accumulates the ValidationErrors found in a Config.
+type validator struct {
+	errs ValidationErrors
+}
+
+func (v *validator) errorf(path, format string, a ...interface{}) {
+	v.errs = append(v.errs, ValidationError{
+		Path:    path,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// enum checks that value is one of the values of the given enum type. Empty
+// values are not checked, as they are omitted from the config.
+func (v *validator) enum(path, enumType, value string) {
+	if value == "" {
+		return
+	}
+	for _, valid := range validEnums[enumType] {
+		if value == valid {
+			return
+		}
+	}
+	v.errorf(path, `invalid %s "%s", must be one of %s`, enumType, value,
+		strings.Join(validEnums[enumType], ", "))
+}
+
+// unique checks that name hasn't been seen before, and adds it to seen.
+func (v *validator) unique(path, kind, name string, seen map[string]bool) {
+	if name == "" {
+		v.errorf(path, "%s name is required", kind)
+		return
+	}
+	if seen[name] {
+		v.errorf(path, `duplicate %s "%s"`, kind, name)
+	}
+	seen[name] = true
+}
+
+// ref checks that a referenced object is declared in the config. Undeclared
+// objects are only a warning, as they may exist in Lagoon already.
+func (v *validator) ref(path, kind, name string, declared map[string]bool) {
+	if !declared[name] {
+		v.errs = append(v.errs, ValidationError{
+			Path:    path,
+			Message: fmt.Sprintf(`%s "%s" is not declared`, kind, name),
+			Warning: true,
+		})
+	}
+}
+
+// envVariables checks a list of envVariables.
+func (v *validator) envVariables(path string, evs []EnvKeyValue) {
+	seen := map[string]bool{}
+	for i, ev := range evs {
+		evPath := fmt.Sprintf("%s[%d]", path, i)
+		v.unique(evPath+".name", "envVariable", ev.Name, seen)
+		v.enum(evPath+".scope", "EnvVariableScope", string(ev.Scope))
+	}
+}
+
+// ValidateConfig checks the referential integrity and enum values of a Config.
+// The returned ValidationErrors have a Path but no Position, and include
+// warnings.
+func ValidateConfig(config *Config) ValidationErrors {
+	v := validator{}
+	billingGroups := map[string]bool{}
+	for i, bg := range config.BillingGroups {
+		path := fmt.Sprintf("billingGroups[%d]", i)
+		v.unique(path+".name", "billing group", bg.Name, billingGroups)
+		v.enum(path+".currency", "Currency", string(bg.Currency))
+	}
+	users := map[string]bool{}
+	for i, user := range config.Users {
+		path := fmt.Sprintf("users[%d]", i)
+		if user.Email == "" {
+			v.errorf(path+".email", "user email is required")
+		} else if users[user.Email] {
+			v.errorf(path+".email", `duplicate user "%s"`, user.Email)
+		}
+		users[user.Email] = true
+		sshKeys := map[string]bool{}
+		for j, sshKey := range user.SSHKeys {
+			keyPath := fmt.Sprintf("%s.sshKeys[%d]", path, j)
+			v.unique(keyPath+".name", "SSH key", sshKey.Name, sshKeys)
+			v.enum(keyPath+".keyType", "SshKeyType", string(sshKey.KeyType))
+		}
+	}
+	groups := map[string]bool{}
+	for i, group := range config.Groups {
+		path := fmt.Sprintf("groups[%d]", i)
+		v.unique(path+".name", "group", group.Name, groups)
+		for j, u := range group.Users {
+			userPath := fmt.Sprintf("%s.users[%d]", path, j)
+			v.ref(userPath+".email", "user", u.Email, users)
+			v.enum(userPath+".role", "GroupRole", string(u.Role))
+		}
+	}
+	notifications := map[string]map[string]bool{}
+	if config.Notifications != nil {
+		for _, n := range []struct {
+			key   string
+			names []string
+		}{
+			{"slack", slackNames(config.Notifications.Slack)},
+			{"rocketChat", rocketChatNames(config.Notifications.RocketChat)},
+			{"email", emailNames(config.Notifications.Email)},
+			{"microsoftTeams",
+				microsoftTeamsNames(config.Notifications.MicrosoftTeams)},
+		} {
+			notifications[n.key] = map[string]bool{}
+			for i, name := range n.names {
+				v.unique(fmt.Sprintf("notifications.%s[%d].name", n.key, i),
+					n.key+" notification", name, notifications[n.key])
+			}
+		}
+	}
+	projects := map[string]bool{}
+	for i, p := range config.Projects {
+		path := fmt.Sprintf("projects[%d]", i)
+		v.unique(path+".name", "project", p.Name, projects)
+		v.envVariables(path+".envVariables", p.EnvVariables)
+		environments := map[string]bool{}
+		for j, env := range p.Environments {
+			envPath := fmt.Sprintf("%s.environments[%d]", path, j)
+			v.unique(envPath+".name", "environment", env.Name, environments)
+			v.enum(envPath+".deployType", "DeployType", string(env.DeployType))
+			v.enum(envPath+".environmentType", "EnvType",
+				string(env.EnvironmentType))
+			v.envVariables(envPath+".envVariables", env.EnvVariables)
+		}
+		for j, name := range p.Groups {
+			v.ref(fmt.Sprintf("%s.groups[%d]", path, j), "group", name, groups)
+		}
+		if len(p.BillingGroups) > 1 {
+			v.errorf(path+".billingGroups",
+				"project can only have one billing group: %v", p.BillingGroups)
+		}
+		for j, name := range p.BillingGroups {
+			v.ref(fmt.Sprintf("%s.billingGroups[%d]", path, j), "billing group",
+				name, billingGroups)
+		}
+		for j, u := range p.Users {
+			userPath := fmt.Sprintf("%s.users[%d]", path, j)
+			v.ref(userPath+".email", "user", u.Email, users)
+			v.enum(userPath+".role", "GroupRole", string(u.Role))
+		}
+		if p.Notifications == nil {
+			continue
+		}
+		for _, n := range []struct {
+			key   string
+			names []string
+		}{
+			{"slack", p.Notifications.Slack},
+			{"rocketChat", p.Notifications.RocketChat},
+			{"email", p.Notifications.Email},
+			{"microsoftTeams", p.Notifications.MicrosoftTeams},
+		} {
+			for j, name := range n.names {
+				v.ref(fmt.Sprintf("%s.notifications.%s[%d]", path, n.key, j),
+					n.key+" notification", name, notifications[n.key])
+			}
+		}
+	}
+	return v.errs
+}
+
+// ValidateConfigYAML unmarshals and validates a YAML config file. Problems
+// are returned with the position of each in the file, in file order. Warnings
+// are returned separately, unless strict is true in which case they are
+// treated as errors. If the config is invalid the error is a ValidationErrors.
+func ValidateConfigYAML(data []byte, strict bool) (ValidationErrors, error) {
+	config := Config{}
+	if err := UnmarshalConfigYAML(data, &config); err != nil {
+		return nil, err
+	}
+	problems := ValidateConfig(&config)
+	if len(problems) == 0 {
+		return nil, nil
+	}
+	index := indexPositions(data)
+	for i := range problems {
+		problems[i].Position, _ = index.lookup(problems[i].Path)
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].Position.Line != problems[j].Position.Line {
+			return problems[i].Position.Line < problems[j].Position.Line
+		}
+		return problems[i].Position.Column < problems[j].Position.Column
+	})
+	var warnings, errs ValidationErrors
+	for _, p := range problems {
+		if p.Warning && !strict {
+			warnings = append(warnings, p)
+		} else {
+			errs = append(errs, p)
+		}
+	}
+	if len(errs) > 0 {
+		return warnings, errs
+	}
+	return warnings, nil
+}
+
+func slackNames(ns []AddNotificationSlackInput) []string {
+	names := make([]string, len(ns))
+	for i := range ns {
+		names[i] = ns[i].Name
+	}
+	return names
+}
+
+func rocketChatNames(ns []AddNotificationRocketChatInput) []string {
+	names := make([]string, len(ns))
+	for i := range ns {
+		names[i] = ns[i].Name
+	}
+	return names
+}
+
+func emailNames(ns []AddNotificationEmailInput) []string {
+	names := make([]string, len(ns))
+	for i := range ns {
+		names[i] = ns[i].Name
+	}
+	return names
+}
+
+func microsoftTeamsNames(ns []AddNotificationMicrosoftTeamsInput) []string {
+	names := make([]string, len(ns))
+	for i := range ns {
+		names[i] = ns[i].Name
+	}
+	return names
+}
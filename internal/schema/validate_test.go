@@ -0,0 +1,80 @@
+package schema_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/schema"
+)
+
+func TestValidateConfigYAML(t *testing.T) {
+	var testCases = map[string]struct {
+		input    string
+		strict   bool
+		expect   string
+		warnings int
+	}{
+		"valid": {
+			input: "testdata/singleProject.golden.yaml",
+		},
+		"invalid": {
+			input:    "testdata/invalid.config.yaml",
+			expect:   "testdata/invalid.golden.txt",
+			warnings: 4,
+		},
+		"invalidStrict": {
+			input:  "testdata/invalid.config.yaml",
+			strict: true,
+			expect: "testdata/invalidStrict.golden.txt",
+		},
+		"external": {
+			input:    "testdata/external.config.yaml",
+			warnings: 5,
+		},
+		"externalStrict": {
+			input:  "testdata/external.config.yaml",
+			strict: true,
+			expect: "testdata/externalStrict.golden.txt",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			data, err := ioutil.ReadFile(tc.input)
+			if err != nil {
+				tt.Fatalf("couldn't read file: %v", err)
+			}
+			warnings, err := schema.ValidateConfigYAML(data, tc.strict)
+			if len(warnings) != tc.warnings {
+				tt.Errorf("expected %d warnings, got %d: %v",
+					tc.warnings, len(warnings), warnings)
+			}
+			if tc.expect == "" {
+				if err != nil {
+					tt.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if _, ok := err.(schema.ValidationErrors); !ok {
+				tt.Fatalf("expected ValidationErrors, got %v", err)
+			}
+			result := []byte(err.Error() + "\n")
+
+			if *update {
+				tt.Logf("update golden file: %s", tc.expect)
+				if err = ioutil.WriteFile(tc.expect, result, 0644); err != nil {
+					tt.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+
+			expected, err := ioutil.ReadFile(tc.expect)
+			if err != nil {
+				tt.Fatalf("failed reading golden file: %v", err)
+			}
+			if !bytes.Equal(result, expected) {
+				tt.Logf("result:\n%s\nexpected:\n%s", result, expected)
+				tt.Errorf("result does not match expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package tokenstore
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+// scrypt parameters recommended for interactive logins.
+const (
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// ErrPassphrase is returned when a file can't be decrypted with the given
+// passphrase.
+var ErrPassphrase = errors.New("wrong passphrase or corrupted token file")
+
+// EncryptedFile stores secrets in a file encrypted with a key derived from a
+// passphrase using scrypt, and sealed with NaCl secretbox.
+type EncryptedFile struct {
+	// Path is the path to the encrypted file.
+	Path string
+	// Passphrase returns the passphrase of the file. create is true if the file
+	// doesn't exist yet, so that the passphrase can be confirmed. It is called
+	// at most once.
+	Passphrase func(create bool) (string, error)
+
+	passphrase string
+}
+
+// encryptedFile is the format of the encrypted file.
+type encryptedFile struct {
+	Version int    `json:"version"`
+	Salt    []byte `json:"salt"`
+	Nonce   []byte `json:"nonce"`
+	Box     []byte `json:"box"`
+}
+
+// Get implements Store.
+func (e *EncryptedFile) Get(lagoon, key string) (string, error) {
+	secrets, err := e.load()
+	if err != nil {
+		return "", err
+	}
+	return secrets[lagoon+"."+key], nil
+}
+
+// Set implements Store.
+func (e *EncryptedFile) Set(lagoon, key, secret string) error {
+	secrets, err := e.load()
+	if err != nil {
+		return err
+	}
+	secrets[lagoon+"."+key] = secret
+	return e.save(secrets)
+}
+
+// Delete implements Store.
+func (e *EncryptedFile) Delete(lagoon, key string) error {
+	secrets, err := e.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[lagoon+"."+key]; !ok {
+		return nil
+	}
+	delete(secrets, lagoon+"."+key)
+	return e.save(secrets)
+}
+
+// getPassphrase returns the passphrase of the file, asking for it if it isn't
+// known yet.
+func (e *EncryptedFile) getPassphrase(create bool) (string, error) {
+	if e.passphrase != "" {
+		return e.passphrase, nil
+	}
+	passphrase, err := e.Passphrase(create)
+	if err != nil {
+		return "", err
+	}
+	if passphrase == "" {
+		return "", fmt.Errorf("the token file passphrase can't be empty")
+	}
+	e.passphrase = passphrase
+	return passphrase, nil
+}
+
+// load decrypts the secrets in the file. If the file doesn't exist, an empty
+// map is returned.
+func (e *EncryptedFile) load() (map[string]string, error) {
+	data, err := ioutil.ReadFile(e.Path)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read token file: %v", err)
+	}
+	var f encryptedFile
+	if err = json.Unmarshal(data, &f); err != nil || f.Version != 1 ||
+		len(f.Nonce) != 24 {
+		return nil, fmt.Errorf("couldn't parse token file %s", e.Path)
+	}
+	passphrase, err := e.getPassphrase(false)
+	if err != nil {
+		return nil, err
+	}
+	key, err := deriveKey(passphrase, f.Salt)
+	if err != nil {
+		return nil, err
+	}
+	var nonce [24]byte
+	copy(nonce[:], f.Nonce)
+	plaintext, ok := secretbox.Open(nil, f.Box, &nonce, key)
+	if !ok {
+		e.passphrase = "" // allow a retry with another passphrase
+		return nil, ErrPassphrase
+	}
+	secrets := map[string]string{}
+	if err = json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, ErrPassphrase
+	}
+	return secrets, nil
+}
+
+// save encrypts the secrets with a new salt and nonce, and replaces the file.
+func (e *EncryptedFile) save(secrets map[string]string) error {
+	_, err := os.Stat(e.Path)
+	passphrase, err := e.getPassphrase(os.IsNotExist(err))
+	if err != nil {
+		return err
+	}
+	f := encryptedFile{
+		Version: 1,
+		Salt:    make([]byte, 16),
+		Nonce:   make([]byte, 24),
+	}
+	if _, err = rand.Read(f.Salt); err != nil {
+		return err
+	}
+	if _, err = rand.Read(f.Nonce); err != nil {
+		return err
+	}
+	key, err := deriveKey(passphrase, f.Salt)
+	if err != nil {
+		return err
+	}
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+	var nonce [24]byte
+	copy(nonce[:], f.Nonce)
+	f.Box = secretbox.Seal(nil, plaintext, &nonce, key)
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first so that the file is never truncated
+	tmp, err := ioutil.TempFile(filepath.Dir(e.Path), ".lagoon-tokens")
+	if err != nil {
+		return fmt.Errorf("couldn't write token file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("couldn't write token file: %v", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("couldn't write token file: %v", err)
+	}
+	if err = os.Rename(tmp.Name(), e.Path); err != nil {
+		return fmt.Errorf("couldn't write token file: %v", err)
+	}
+	return nil
+}
+
+func deriveKey(passphrase string, salt []byte) (*[32]byte, error) {
+	k, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't derive key: %v", err)
+	}
+	var key [32]byte
+	copy(key[:], k)
+	return &key, nil
+}
@@ -0,0 +1,54 @@
+package tokenstore_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/tokenstore"
+)
+
+func TestEncryptedFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lagoon-cli-tokenstore")
+	if err != nil {
+		t.Fatalf("couldn't create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tokens")
+	var prompts []bool
+	passphrase := func(passphrase string) func(bool) (string, error) {
+		return func(create bool) (string, error) {
+			prompts = append(prompts, create)
+			return passphrase, nil
+		}
+	}
+	testStore(t, &tokenstore.EncryptedFile{Path: path,
+		Passphrase: passphrase("secret")})
+	if len(prompts) != 1 || !prompts[0] {
+		t.Fatalf("expected a single prompt to create the file, got %v", prompts)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("couldn't read token file: %v", err)
+	}
+	for _, secret := range []string{"t2", "r1", "local"} {
+		if bytes.Contains(data, []byte(secret)) {
+			t.Fatalf("token file contains plaintext %q", secret)
+		}
+	}
+
+	prompts = nil
+	s := &tokenstore.EncryptedFile{Path: path, Passphrase: passphrase("secret")}
+	if secret, err := s.Get("local", "token"); err != nil || secret != "t2" {
+		t.Fatalf("expected token t2, got %q, %v", secret, err)
+	}
+	if len(prompts) != 1 || prompts[0] {
+		t.Fatalf("expected a single prompt to open the file, got %v", prompts)
+	}
+	s = &tokenstore.EncryptedFile{Path: path, Passphrase: passphrase("wrong")}
+	if _, err := s.Get("local", "token"); err != tokenstore.ErrPassphrase {
+		t.Fatalf("expected passphrase error, got %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package tokenstore
+
+// Config is the subset of the methods of viper.Viper used by File.
+type Config interface {
+	Get(key string) interface{}
+	GetString(key string) string
+	Set(key string, value interface{})
+	WriteConfig() error
+}
+
+// File stores secrets in plaintext in the config file, under
+// lagoons.<lagoon>.<key>.
+type File struct {
+	Config Config
+}
+
+// Get implements Store.
+func (f *File) Get(lagoon, key string) (string, error) {
+	return f.Config.GetString("lagoons." + lagoon + "." + key), nil
+}
+
+// Set implements Store.
+func (f *File) Set(lagoon, key, secret string) error {
+	// the secret is stored in the existing settings of the lagoon, so that
+	// Delete can remove it from the same map
+	if settings, ok := f.settings(lagoon); ok {
+		settings[key] = secret
+	} else {
+		f.Config.Set("lagoons."+lagoon+"."+key, secret)
+	}
+	return f.Config.WriteConfig()
+}
+
+// Delete implements Store. The key is removed from the settings of the lagoon
+// and the config file is rewritten.
+func (f *File) Delete(lagoon, key string) error {
+	settings, ok := f.settings(lagoon)
+	if !ok {
+		return nil
+	}
+	if _, ok = settings[key]; !ok {
+		return nil
+	}
+	delete(settings, key)
+	return f.Config.WriteConfig()
+}
+
+// settings returns the settings of the lagoon in the config.
+func (f *File) settings(lagoon string) (map[string]interface{}, bool) {
+	settings, ok := f.Config.Get("lagoons." + lagoon).(map[string]interface{})
+	return settings, ok
+}
@@ -0,0 +1,49 @@
+package tokenstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/tokenstore"
+	"github.com/spf13/viper"
+)
+
+func TestFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lagoon-cli-tokenstore")
+	if err != nil {
+		t.Fatalf("couldn't create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, ".lagoon.yml")
+	err = ioutil.WriteFile(path,
+		[]byte("lagoons:\n  amazeeio:\n    hostname: ssh.lagoon.amazeeio.cloud\n"+
+			"    token: t0\n"), 0600)
+	if err != nil {
+		t.Fatalf("couldn't write config: %v", err)
+	}
+	config := viper.New()
+	config.SetConfigFile(path)
+	if err = config.ReadInConfig(); err != nil {
+		t.Fatalf("couldn't read config: %v", err)
+	}
+	testStore(t, &tokenstore.File{Config: config})
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("couldn't read config: %v", err)
+	}
+	for _, expect := range []string{"hostname: ssh.lagoon.amazeeio.cloud",
+		"refresh-token: r1", "token: t2"} {
+		if !strings.Contains(string(data), expect) {
+			t.Fatalf("expected %q in config, got:\n%s", expect, data)
+		}
+	}
+	if strings.Contains(string(data), "token: t0") ||
+		strings.Contains(string(data), "token: t1") ||
+		strings.Contains(string(data), "token: \"\"") {
+		t.Fatalf("expected deleted token to be removed from config, got:\n%s",
+			data)
+	}
+}
@@ -0,0 +1,76 @@
+package tokenstore
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Keyring stores secrets in the Secret Service of the desktop session, such as
+// GNOME Keyring or KWallet, using the secret-tool command of libsecret to talk
+// to it over D-Bus. secret-tool is only available on Linux.
+type Keyring struct {
+	// Service is the value of the service attribute of the stored secrets.
+	Service string
+	// Command is the secret-tool command. If empty, secret-tool is looked up
+	// in $PATH.
+	Command string
+}
+
+// Get implements Store.
+func (k *Keyring) Get(lagoon, key string) (string, error) {
+	out, err := k.run("", append([]string{"lookup"},
+		k.attributes(lagoon, key)...)...)
+	if _, ok := err.(*exec.ExitError); ok && out == "" {
+		// secret-tool exits 1 without any output if there is no secret
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
+// Set implements Store.
+func (k *Keyring) Set(lagoon, key, secret string) error {
+	label := fmt.Sprintf("Lagoon %s for %s", key, lagoon)
+	_, err := k.run(secret, append([]string{"store", "--label=" + label},
+		k.attributes(lagoon, key)...)...)
+	return err
+}
+
+// Delete implements Store.
+func (k *Keyring) Delete(lagoon, key string) error {
+	_, err := k.run("", append([]string{"clear"},
+		k.attributes(lagoon, key)...)...)
+	return err
+}
+
+func (k *Keyring) attributes(lagoon, key string) []string {
+	return []string{"service", k.Service, "lagoon", lagoon, "key", key}
+}
+
+// run runs secret-tool with the given arguments and stdin, and returns its
+// trimmed stdout.
+func (k *Keyring) run(stdin string, args ...string) (string, error) {
+	command := k.Command
+	if command == "" {
+		var err error
+		if command, err = exec.LookPath("secret-tool"); err != nil {
+			return "", fmt.Errorf("the keyring token store requires " +
+				"secret-tool (libsecret) to access the Secret Service")
+		}
+	}
+	cmd := exec.Command(command, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil && stderr.Len() > 0 {
+		return stdout.String(), fmt.Errorf("keyring: %s: %w",
+			strings.TrimSpace(stderr.String()), err)
+	}
+	return strings.TrimSpace(stdout.String()), err
+}
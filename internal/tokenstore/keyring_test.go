@@ -0,0 +1,43 @@
+package tokenstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/tokenstore"
+)
+
+func TestKeyring(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake secret-tool is a shell script")
+	}
+	dir, err := ioutil.TempDir("", "lagoon-cli-tokenstore")
+	if err != nil {
+		t.Fatalf("couldn't create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	// the fake secret-tool stores each secret in a file named after its
+	// attributes
+	command := filepath.Join(dir, "secret-tool")
+	err = ioutil.WriteFile(command, []byte(`#!/bin/sh
+op=$1; shift
+[ "$op" = store ] && shift
+f="`+dir+`/$(echo "$@" | tr ' ' _)"
+case $op in
+store) cat > "$f" ;;
+lookup) [ -f "$f" ] && cat "$f" || exit 1 ;;
+clear) rm -f "$f" ;;
+esac
+`), 0700)
+	if err != nil {
+		t.Fatalf("couldn't write fake secret-tool: %v", err)
+	}
+	testStore(t, &tokenstore.Keyring{Service: "lagoon-cli", Command: command})
+	if _, err = os.Stat(filepath.Join(dir,
+		"service_lagoon-cli_lagoon_local_key_token")); err != nil {
+		t.Fatalf("expected the token to be stored: %v", err)
+	}
+}
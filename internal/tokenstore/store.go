@@ -0,0 +1,15 @@
+// Package tokenstore implements the stores the CLI can keep Lagoon API tokens
+// in.
+package tokenstore
+
+// A Store stores secrets, such as API tokens and refresh tokens, by lagoon and
+// key.
+type Store interface {
+	// Get returns the secret stored for the lagoon under key, or an empty
+	// string if there is none.
+	Get(lagoon, key string) (string, error)
+	// Set stores the secret for the lagoon under key.
+	Set(lagoon, key, secret string) error
+	// Delete removes the secret stored for the lagoon under key, if any.
+	Delete(lagoon, key string) error
+}
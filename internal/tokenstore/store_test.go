@@ -0,0 +1,49 @@
+package tokenstore_test
+
+import (
+	"testing"
+
+	"github.com/amazeeio/lagoon-cli/internal/tokenstore"
+)
+
+// testStore checks that secrets can be stored, read and deleted.
+func testStore(t *testing.T, s tokenstore.Store) {
+	t.Helper()
+	if err := s.Set("amazeeio", "token", "t1"); err != nil {
+		t.Fatalf("couldn't set token: %v", err)
+	}
+	if err := s.Set("amazeeio", "refresh-token", "r1"); err != nil {
+		t.Fatalf("couldn't set refresh token: %v", err)
+	}
+	if err := s.Set("local", "token", "t2"); err != nil {
+		t.Fatalf("couldn't set token: %v", err)
+	}
+	for _, c := range []struct{ lagoon, key, expect string }{
+		{"amazeeio", "token", "t1"},
+		{"amazeeio", "refresh-token", "r1"},
+		{"local", "token", "t2"},
+		{"local", "refresh-token", ""},
+		{"unknown", "token", ""},
+	} {
+		secret, err := s.Get(c.lagoon, c.key)
+		if err != nil {
+			t.Fatalf("couldn't get %s %s: %v", c.lagoon, c.key, err)
+		}
+		if secret != c.expect {
+			t.Fatalf("expected %s %s %q, got %q", c.lagoon, c.key, c.expect,
+				secret)
+		}
+	}
+	if err := s.Delete("amazeeio", "token"); err != nil {
+		t.Fatalf("couldn't delete token: %v", err)
+	}
+	if err := s.Delete("amazeeio", "token"); err != nil {
+		t.Fatalf("couldn't delete deleted token: %v", err)
+	}
+	if secret, _ := s.Get("amazeeio", "token"); secret != "" {
+		t.Fatalf("expected deleted token, got %q", secret)
+	}
+	if secret, _ := s.Get("local", "token"); secret != "t2" {
+		t.Fatalf("expected token t2, got %q", secret)
+	}
+}
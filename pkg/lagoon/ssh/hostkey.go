@@ -0,0 +1,189 @@
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// HostKeyConfig configures verification of the host key of a Lagoon SSH
+// service.
+type HostKeyConfig struct {
+	// Fingerprints are the SHA256 fingerprints of the accepted host keys. If
+	// any are set the known_hosts files are ignored.
+	Fingerprints []string
+	// KnownHostsFile is the lagoon-specific known_hosts file. Unknown host keys
+	// are trusted on first use and added to this file.
+	KnownHostsFile string
+	// UserKnownHostsFile is the user's known_hosts file, usually
+	// ~/.ssh/known_hosts. It is read but never written to.
+	UserKnownHostsFile string
+}
+
+// HostKeyError is returned when the host key presented by a Lagoon doesn't
+// match the expected host key.
+type HostKeyError struct {
+	Hostname    string
+	Fingerprint string
+	// Want are the fingerprints of the expected host keys.
+	Want []string
+	// Source describes where the expected host keys are configured.
+	Source string
+}
+
+func (e *HostKeyError) Error() string {
+	return fmt.Sprintf("host key verification failed for %s: the server "+
+		"presented a host key with fingerprint %s, but %s expects %s. "+
+		"This could mean that someone is intercepting the connection. If the "+
+		"host key has changed legitimately, update %s",
+		e.Hostname, e.Fingerprint, e.Source, strings.Join(e.Want, " or "),
+		e.Source)
+}
+
+// normalizeFingerprint adds the SHA256: prefix to a fingerprint if it is
+// missing.
+func normalizeFingerprint(fingerprint string) string {
+	if strings.HasPrefix(fingerprint, "SHA256:") {
+		return fingerprint
+	}
+	return "SHA256:" + fingerprint
+}
+
+// HostKeyCallback returns an ssh.HostKeyCallback which verifies host keys
+// according to c.
+func (c HostKeyConfig) HostKeyCallback() ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		fingerprint := ssh.FingerprintSHA256(key)
+		if len(c.Fingerprints) > 0 {
+			var want []string
+			for _, f := range c.Fingerprints {
+				if normalizeFingerprint(f) == fingerprint {
+					return nil
+				}
+				want = append(want, normalizeFingerprint(f))
+			}
+			return &HostKeyError{
+				Hostname:    hostname,
+				Fingerprint: fingerprint,
+				Want:        want,
+				Source:      "the pinned host-key-fingerprints in the lagoon config",
+			}
+		}
+
+		known, err := c.knownHostKeys(hostname, remote)
+		if err != nil {
+			return err
+		}
+		// like OpenSSH, any known key of the host must match, since the
+		// server is made to present a known type of key by HostKeyAlgorithms
+		var want, lines []string
+		for _, k := range known {
+			if ssh.FingerprintSHA256(k.Key) == fingerprint {
+				return nil
+			}
+			want = append(want, ssh.FingerprintSHA256(k.Key))
+			lines = append(lines, fmt.Sprintf("%s:%d", k.Filename, k.Line))
+		}
+		if len(want) > 0 {
+			return &HostKeyError{
+				Hostname:    hostname,
+				Fingerprint: fingerprint,
+				Want:        want,
+				Source:      strings.Join(lines, ", "),
+			}
+		}
+		// the host is unknown, so trust it on first use
+		return c.addHostKey(hostname, key)
+	}
+}
+
+// HostKeyAlgorithms returns the types of the keys in the known_hosts files
+// for the host at addr, in the form host:port, so that they can be set as the
+// HostKeyAlgorithms of an ssh.ClientConfig. This makes the server present a
+// key which can be verified if it has one. If no keys are known, or the
+// fingerprints are pinned, nil is returned so that the default algorithms are
+// used.
+func (c HostKeyConfig) HostKeyAlgorithms(addr string) []string {
+	if len(c.Fingerprints) > 0 {
+		return nil
+	}
+	known, err := c.knownHostKeys(addr, &net.TCPAddr{})
+	if err != nil {
+		return nil // the error is returned by the HostKeyCallback
+	}
+	var algorithms []string
+	for _, k := range known {
+		if k.Key.Type() == ssh.KeyAlgoRSA {
+			// RSA keys are also used with SHA-2 signatures
+			algorithms = append(algorithms, ssh.SigAlgoRSASHA2512,
+				ssh.SigAlgoRSASHA2256)
+		}
+		algorithms = append(algorithms, k.Key.Type())
+	}
+	sort.Strings(algorithms)
+	return algorithms
+}
+
+// knownHostKeys returns the keys in the known_hosts files for hostname, at
+// most one of each type. Keys in the lagoon-specific known_hosts file take
+// precedence.
+func (c HostKeyConfig) knownHostKeys(hostname string,
+	remote net.Addr) ([]knownhosts.KnownKey, error) {
+	var files []string
+	for _, f := range []string{c.KnownHostsFile, c.UserKnownHostsFile} {
+		if _, err := os.Stat(f); f != "" && err == nil {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil, nil
+	}
+	check, err := knownhosts.New(files...)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read known_hosts: %v", err)
+	}
+	// checking a key which can't be known returns all the known keys
+	err = check(hostname, remote, unknownKey{})
+	var keyErr *knownhosts.KeyError
+	if !errors.As(err, &keyErr) {
+		return nil, err
+	}
+	return keyErr.Want, nil
+}
+
+// unknownKey is an ssh.PublicKey which never appears in known_hosts.
+type unknownKey struct{}
+
+func (unknownKey) Type() string    { return "lagoon-unknown" }
+func (unknownKey) Marshal() []byte { return []byte("lagoon-unknown") }
+func (unknownKey) Verify([]byte, *ssh.Signature) error {
+	return errors.New("unknown key")
+}
+
+// addHostKey adds the host key to the lagoon-specific known_hosts file.
+func (c HostKeyConfig) addHostKey(hostname string, key ssh.PublicKey) error {
+	if c.KnownHostsFile == "" {
+		return fmt.Errorf("host key verification failed for %s: unknown host",
+			hostname)
+	}
+	file, err := os.OpenFile(c.KnownHostsFile,
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("couldn't open known_hosts: %v", err)
+	}
+	defer file.Close()
+	line := knownhosts.Line([]string{knownhosts.Normalize(hostname)}, key)
+	if _, err = fmt.Fprintln(file, line); err != nil {
+		return fmt.Errorf("couldn't write known_hosts: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "Warning: Permanently added '%s' (%s %s) to the "+
+		"list of known hosts in %s\n", hostname, key.Type(),
+		ssh.FingerprintSHA256(key), c.KnownHostsFile)
+	return nil
+}
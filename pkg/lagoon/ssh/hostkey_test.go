@@ -0,0 +1,151 @@
+package ssh
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func newHostKey(t *testing.T) ssh.PublicKey {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("couldn't generate key: %v", err)
+	}
+	key, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatalf("couldn't convert key: %v", err)
+	}
+	return key
+}
+
+func newECDSAHostKey(t *testing.T) ssh.PublicKey {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("couldn't generate key: %v", err)
+	}
+	key, err := ssh.NewPublicKey(&priv.PublicKey)
+	if err != nil {
+		t.Fatalf("couldn't convert key: %v", err)
+	}
+	return key
+}
+
+func TestHostKeyCallback(t *testing.T) {
+	hostname := "ssh.lagoon.example.com:32222"
+	remote := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 32222}
+	key, otherKey := newHostKey(t), newHostKey(t)
+	ecdsaKey := newECDSAHostKey(t)
+	var testCases = map[string]struct {
+		fingerprints []string
+		userKnown    ssh.PublicKey // added to the user's known_hosts
+		keys         []ssh.PublicKey
+		expectErr    []bool
+		expectAdded  bool
+	}{
+		"trust on first use": {
+			keys:        []ssh.PublicKey{key, key, otherKey},
+			expectErr:   []bool{false, false, true},
+			expectAdded: true,
+		},
+		"user known_hosts": {
+			userKnown: key,
+			keys:      []ssh.PublicKey{key, otherKey},
+			expectErr: []bool{false, true},
+		},
+		"user known_hosts of a different key type": {
+			userKnown: ecdsaKey,
+			keys:      []ssh.PublicKey{key, ecdsaKey},
+			expectErr: []bool{true, false},
+		},
+		"pinned": {
+			fingerprints: []string{ssh.FingerprintSHA256(key)},
+			keys:         []ssh.PublicKey{key, otherKey},
+			expectErr:    []bool{false, true},
+		},
+		"pinned without prefix": {
+			fingerprints: []string{ssh.FingerprintSHA256(key)[len("SHA256:"):]},
+			keys:         []ssh.PublicKey{key},
+			expectErr:    []bool{false},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(tt *testing.T) {
+			dir, err := ioutil.TempDir("", "lagoon-cli-hostkey")
+			if err != nil {
+				tt.Fatalf("couldn't create temp dir: %v", err)
+			}
+			defer os.RemoveAll(dir)
+			c := HostKeyConfig{
+				Fingerprints:       tc.fingerprints,
+				KnownHostsFile:     filepath.Join(dir, "lagoon_known_hosts"),
+				UserKnownHostsFile: filepath.Join(dir, "known_hosts"),
+			}
+			if tc.userKnown != nil {
+				line := knownhosts.Line(
+					[]string{knownhosts.Normalize(hostname)}, tc.userKnown)
+				err = ioutil.WriteFile(c.UserKnownHostsFile, []byte(line+"\n"), 0600)
+				if err != nil {
+					tt.Fatalf("couldn't write known_hosts: %v", err)
+				}
+			}
+			callback := c.HostKeyCallback()
+			for i, k := range tc.keys {
+				err = callback(hostname, remote, k)
+				if tc.expectErr[i] {
+					var hostKeyErr *HostKeyError
+					if !errors.As(err, &hostKeyErr) {
+						tt.Fatalf("key %d: expected HostKeyError, got %v", i, err)
+					}
+				} else if err != nil {
+					tt.Fatalf("key %d: unexpected error: %v", i, err)
+				}
+			}
+			_, err = os.Stat(c.KnownHostsFile)
+			if added := err == nil; added != tc.expectAdded {
+				tt.Fatalf("expected added %v, got %v", tc.expectAdded, added)
+			}
+		})
+	}
+}
+
+func TestHostKeyAlgorithms(t *testing.T) {
+	hostname := "ssh.lagoon.example.com:32222"
+	dir, err := ioutil.TempDir("", "lagoon-cli-hostkey")
+	if err != nil {
+		t.Fatalf("couldn't create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	c := HostKeyConfig{
+		KnownHostsFile:     filepath.Join(dir, "lagoon_known_hosts"),
+		UserKnownHostsFile: filepath.Join(dir, "known_hosts"),
+	}
+	if algorithms := c.HostKeyAlgorithms(hostname); algorithms != nil {
+		t.Fatalf("expected no algorithms for an unknown host, got %v",
+			algorithms)
+	}
+	line := knownhosts.Line([]string{knownhosts.Normalize(hostname)},
+		newECDSAHostKey(t))
+	err = ioutil.WriteFile(c.UserKnownHostsFile, []byte(line+"\n"), 0600)
+	if err != nil {
+		t.Fatalf("couldn't write known_hosts: %v", err)
+	}
+	algorithms := c.HostKeyAlgorithms(hostname)
+	if len(algorithms) != 1 || algorithms[0] != ssh.KeyAlgoECDSA256 {
+		t.Fatalf("expected [%s], got %v", ssh.KeyAlgoECDSA256, algorithms)
+	}
+	if algorithms = c.HostKeyAlgorithms("other.example.com:22"); algorithms != nil {
+		t.Fatalf("expected no algorithms for another host, got %v", algorithms)
+	}
+}
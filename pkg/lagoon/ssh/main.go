@@ -118,9 +118,15 @@ func RunSSHCommand(lagoon map[string]string, sshService string, sshContainer str
 	return nil
 }
 
-// GenerateSSHConnectionString .
+// GenerateSSHConnectionString returns an ssh command line to connect to a
+// Lagoon environment. If lagoon["knownhosts"] is set, it is passed as the
+// UserKnownHostsFile option so that ssh checks the same known_hosts files as
+// the CLI.
 func GenerateSSHConnectionString(lagoon map[string]string, service string, container string) string {
-	connString := fmt.Sprintf("ssh -t -o \"UserKnownHostsFile=/dev/null\" -o \"StrictHostKeyChecking=no\" -p %v %s@%s", lagoon["port"], lagoon["username"], lagoon["hostname"])
+	connString := fmt.Sprintf("ssh -t -p %v %s@%s", lagoon["port"], lagoon["username"], lagoon["hostname"])
+	if lagoon["knownhosts"] != "" {
+		connString = fmt.Sprintf("ssh -t -o \"UserKnownHostsFile=%s\" -p %v %s@%s", lagoon["knownhosts"], lagoon["port"], lagoon["username"], lagoon["hostname"])
+	}
 	if service != "" {
 		connString = fmt.Sprintf("%s service=%s", connString, service)
 	}